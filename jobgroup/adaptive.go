@@ -0,0 +1,155 @@
+package jobgroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThinkChaos/parcour/zync"
+)
+
+// DefaultAdaptiveBackoff is the factor used when `AdaptiveConcurrency.Backoff` is not in `(0, 1)`.
+const DefaultAdaptiveBackoff = 0.5
+
+var _ jobGroup = (*adaptiveConcurrency)(nil)
+
+// AdaptiveConcurrency configures a `WithAdaptiveConcurrency` group.
+type AdaptiveConcurrency struct {
+	// Min is the lowest the limit can go. 0 is treated as 1.
+	Min uint
+
+	// Max is the highest the limit can go.
+	// If it is `NoConcurrencyLimit`, the limit can grow indefinitely.
+	Max uint
+
+	// Initial is the limit when the group is created. It is clamped to `[Min, Max]`.
+	Initial uint
+
+	// Backoff is the factor the limit is multiplied by when a job fails.
+	// It must be in `(0, 1)`: otherwise, including if it is 0, `DefaultAdaptiveBackoff` is used.
+	// Other values would never decrease the limit, or drop it straight to `Min`.
+	Backoff float64
+
+	// LatencyThreshold is the duration after which a successful job is considered to have failed.
+	// This allows reacting to a downstream slowing down before it starts returning errors.
+	// If it is 0, latency is ignored.
+	LatencyThreshold time.Duration
+}
+
+type adaptiveConcurrency struct {
+	fairMaxConcurrency
+
+	config AdaptiveConcurrency
+	state  zync.Mutex[aimdState]
+}
+
+// aimdState is the state of the Additive Increase, Multiplicative Decrease algorithm.
+type aimdState struct {
+	// limit is fractional so increases of less than 1 add up.
+	limit float64
+
+	// lastDecrease is used to only decrease once for jobs that ran concurrently.
+	lastDecrease time.Time
+}
+
+// WithAdaptiveConcurrency returns a new `JobGroup`, child of `parent`, that limits the number of concurrent
+// jobs, adjusting the limit based on job outcomes.
+//
+// The limit follows the AIMD algorithm (Additive Increase, Multiplicative Decrease):
+//   - when a job succeeds, the limit increases by `1/limit`, so about 1 per limit's worth of successful jobs.
+//   - when a job fails or is too slow, the limit is multiplied by `config.Backoff`.
+//
+// Failures of jobs that started before the last decrease don't decrease the limit again:
+// they are considered part of the same overload.
+// Errors caused by the group's context ending are not considered failures.
+//
+// Like `WithFairMaxConcurrency`, jobs start in the order they were submitted.
+func WithAdaptiveConcurrency(parent JobGroup, config AdaptiveConcurrency) JobGroup {
+	if config.Min == 0 {
+		config.Min = 1
+	}
+
+	config.Max = limitToSemaphore(config.Max)
+
+	if config.Max < config.Min {
+		config.Max = config.Min
+	}
+
+	if config.Initial < config.Min {
+		config.Initial = config.Min
+	} else if config.Initial > config.Max {
+		config.Initial = config.Max
+	}
+
+	if !(config.Backoff > 0 && config.Backoff < 1) { // also catches NaN
+		config.Backoff = DefaultAdaptiveBackoff
+	}
+
+	return initGroup(parent.Ctx(), &adaptiveConcurrency{
+		fairMaxConcurrency: fairMaxConcurrency{
+			withParent: newWithParent(parent),
+
+			sem: newSemaphore(config.Initial),
+		},
+
+		config: config,
+		state: zync.NewMutex(aimdState{
+			limit:        float64(config.Initial),
+			lastDecrease: time.Time{},
+		}),
+	})
+}
+
+func (g *adaptiveConcurrency) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *adaptiveConcurrency) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithAdaptiveConcurrency"
+	snapshot.Concurrency = g.sem.usage()
+}
+
+func (g *adaptiveConcurrency) launch(job *boundJob) {
+	// Wrap before `fairMaxConcurrency` so time waiting to start isn't measured.
+	job.Wrap(func(userJob Job) Job {
+		return func(ctx context.Context) error {
+			start := time.Now()
+
+			err := userJob(ctx)
+
+			if ctx.Err() == nil {
+				g.record(start, time.Since(start), err)
+			}
+
+			return err
+		}
+	})
+
+	g.fairMaxConcurrency.launch(job)
+}
+
+// record updates the limit based on the outcome of a job.
+func (g *adaptiveConcurrency) record(start time.Time, duration time.Duration, err error) {
+	failed := err != nil || (g.config.LatencyThreshold != 0 && duration > g.config.LatencyThreshold)
+
+	g.state.WithLock(func(state *aimdState) {
+		if failed {
+			if start.Before(state.lastDecrease) {
+				return
+			}
+
+			state.limit *= g.config.Backoff
+			state.lastDecrease = time.Now()
+		} else {
+			state.limit += 1 / state.limit
+		}
+
+		if state.limit < float64(g.config.Min) {
+			state.limit = float64(g.config.Min)
+		} else if state.limit > float64(g.config.Max) {
+			state.limit = float64(g.config.Max)
+		}
+
+		// Still holding the lock so updates are applied in order.
+		g.sem.setLimit(uint(state.limit))
+	})
+}
@@ -0,0 +1,223 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("adaptiveConcurrency", func() {
+	var (
+		sutParent     *MockjobGroup
+		sutParentCtx  *identifiableContext
+		sutParentImpl jobGroup
+
+		sut       *adaptiveConcurrency
+		sutConfig AdaptiveConcurrency
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+
+		sutParentCtx = newIdentifiableContext(context.Background())
+		DeferCleanup(sutParentCtx.Cancel)
+
+		sutParent = NewMockjobGroup(ctrl)
+
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
+		sutParent.EXPECT().
+			Ctx().
+			Return(sutParentCtx)
+
+		group, _ := WithContext(sutParentCtx)
+		DeferCleanup(group.Close)
+
+		sutParentImpl = downcastGroup(group)
+
+		sutConfig = AdaptiveConcurrency{
+			Min:     2,
+			Max:     10,
+			Initial: 4,
+		}
+	})
+
+	JustBeforeEach(func() {
+		sut = WithAdaptiveConcurrency(sutParent, sutConfig).(*adaptiveConcurrency)
+		Expect(sut).ShouldNot(BeNil())
+		Expect(sut.parent).ShouldNot(BeNil())
+		Expect(sut.parent).Should(BeIdenticalTo(sutParent))
+
+		DeferCleanup(sut.Close)
+
+		sutParent.EXPECT().
+			launch(gomock.Any()).
+			AnyTimes().
+			Do(sutParentImpl.launch)
+	})
+
+	semLimit := func() uint {
+		var limit uint
+
+		sut.sem.state.WithLock(func(state *semaphoreState) {
+			limit = state.limit
+		})
+
+		return limit
+	}
+
+	Describe("WithAdaptiveConcurrency", func() {
+		It("uses the initial limit", func() {
+			Expect(semLimit()).Should(BeNumerically("==", 4))
+		})
+
+		When("the config is empty", func() {
+			BeforeEach(func() {
+				sutConfig = AdaptiveConcurrency{}
+			})
+
+			It("uses defaults", func() {
+				Expect(sut.config.Min).Should(BeNumerically("==", 1))
+				Expect(sut.config.Max).Should(Equal(noSemaphoreLimit))
+				Expect(sut.config.Initial).Should(BeNumerically("==", 1))
+				Expect(sut.config.Backoff).Should(Equal(DefaultAdaptiveBackoff))
+			})
+		})
+
+		DescribeTable("uses the default backoff when it is out of bounds",
+			func(backoff float64) {
+				config := sutConfig
+				config.Backoff = backoff
+
+				sutParent.EXPECT().
+					Ctx().
+					Return(sutParentCtx)
+
+				sut := WithAdaptiveConcurrency(sutParent, config).(*adaptiveConcurrency)
+				DeferCleanup(sut.Close)
+
+				Expect(sut.config.Backoff).Should(Equal(DefaultAdaptiveBackoff))
+			},
+			Entry("negative", -0.5),
+			Entry("one", 1.0),
+			Entry("greater than one", 2.0),
+			Entry("NaN", math.NaN()),
+		)
+
+		When("the initial limit is out of bounds", func() {
+			BeforeEach(func() {
+				sutConfig.Initial = 100
+			})
+
+			It("clamps it", func() {
+				Expect(semLimit()).Should(BeNumerically("==", sutConfig.Max))
+			})
+		})
+	})
+
+	Describe("record", func() {
+		It("increases the limit by about 1 per limit's worth of successes", func() {
+			for i := 0; i < 4; i++ {
+				sut.record(time.Now(), 0, nil)
+			}
+
+			Expect(semLimit()).Should(BeNumerically("==", 4))
+
+			sut.record(time.Now(), 0, nil)
+			Expect(semLimit()).Should(BeNumerically("==", 5))
+		})
+
+		It("doesn't exceed the max", func() {
+			for i := 0; i < 1000; i++ {
+				sut.record(time.Now(), 0, nil)
+			}
+
+			Expect(semLimit()).Should(BeNumerically("==", sutConfig.Max))
+		})
+
+		It("decreases the limit on failure", func() {
+			sut.record(time.Now(), 0, errors.New("test error"))
+			Expect(semLimit()).Should(BeNumerically("==", 2))
+
+			By("not going under the min", func() {
+				sut.record(time.Now(), 0, errors.New("test error"))
+				Expect(semLimit()).Should(BeNumerically("==", sutConfig.Min))
+			})
+		})
+
+		It("decreases the limit only once for concurrent failures", func() {
+			start := time.Now()
+
+			sut.record(start, 0, errors.New("test error 1"))
+			sut.record(start, 0, errors.New("test error 2"))
+
+			sut.state.WithLock(func(state *aimdState) {
+				Expect(state.limit).Should(BeNumerically("==", 2))
+			})
+		})
+
+		When("there is a latency threshold", func() {
+			BeforeEach(func() {
+				sutConfig.LatencyThreshold = time.Second
+			})
+
+			It("decreases the limit for slow jobs", func() {
+				sut.record(time.Now(), 2*time.Second, nil)
+				Expect(semLimit()).Should(BeNumerically("==", 2))
+			})
+		})
+	})
+
+	Describe("Go", func() {
+		It("adjusts the limit based on job outcomes", func(testCtx context.Context) {
+			sut.Go(func(ctx context.Context) error {
+				return errors.New("expected error")
+			})
+
+			_, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(semLimit()).Should(BeNumerically("==", 2))
+
+			for i := 0; i < 3; i++ {
+				sut.Go(func(ctx context.Context) error {
+					return nil
+				})
+			}
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+			Expect(semLimit()).Should(BeNumerically("==", 3))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("ignores errors caused by the group ending", func(testCtx context.Context) {
+			started := make(chan struct{})
+
+			sut.Go(func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDoneErr(ctx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(MatchError(context.Canceled))
+			Expect(semLimit()).Should(BeNumerically("==", sutConfig.Initial))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+})
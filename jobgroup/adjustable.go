@@ -0,0 +1,62 @@
+package jobgroup
+
+var _ jobGroup = (*adjustableMaxConcurrency)(nil)
+
+type adjustableMaxConcurrency struct {
+	fairMaxConcurrency
+}
+
+// ConcurrencyLimit allows changing the limit of a `WithAdjustableMaxConcurrency` group while it is running.
+type ConcurrencyLimit struct {
+	sem *semaphore
+}
+
+// WithAdjustableMaxConcurrency is like `WithFairMaxConcurrency`, but the limit can be changed using
+// the returned `ConcurrencyLimit`.
+//
+// If `max` is `NoConcurrencyLimit`, jobs don't wait until a limit is set.
+func WithAdjustableMaxConcurrency(parent JobGroup, max uint) (JobGroup, *ConcurrencyLimit) {
+	limit := &ConcurrencyLimit{
+		sem: newSemaphore(limitToSemaphore(max)),
+	}
+
+	group := initGroup(parent.Ctx(), &adjustableMaxConcurrency{
+		fairMaxConcurrency: fairMaxConcurrency{
+			withParent: newWithParent(parent),
+
+			sem: limit.sem,
+		},
+	})
+
+	return group, limit
+}
+
+func (g *adjustableMaxConcurrency) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *adjustableMaxConcurrency) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithAdjustableMaxConcurrency"
+	snapshot.Concurrency = g.sem.usage()
+}
+
+// SetLimit changes the maximum number of concurrent jobs of the group.
+//
+// Raising the limit starts waiting jobs immediately.
+// Lowering the limit doesn't affect running jobs: new jobs wait until enough running jobs finish.
+//
+// If `max` is `NoConcurrencyLimit`, jobs no longer wait.
+func (l *ConcurrencyLimit) SetLimit(max uint) {
+	l.sem.setLimit(limitToSemaphore(max))
+}
+
+// Limit returns the current limit.
+func (l *ConcurrencyLimit) Limit() uint {
+	var limit uint
+
+	l.sem.state.WithLock(func(state *semaphoreState) {
+		limit = state.limit
+	})
+
+	return semaphoreToLimit(limit)
+}
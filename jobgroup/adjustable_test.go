@@ -0,0 +1,97 @@
+package jobgroup
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConcurrencyLimit", func() {
+	var (
+		parent JobGroup
+
+		sut      JobGroup
+		sutLimit *ConcurrencyLimit
+	)
+
+	BeforeEach(func() {
+		parent, _ = WithContext(context.Background())
+		DeferCleanup(parent.Close)
+
+		sut, sutLimit = WithAdjustableMaxConcurrency(parent, 1)
+		DeferCleanup(sut.Close)
+	})
+
+	Describe("WithAdjustableMaxConcurrency", func() {
+		It("creates a child group", func() {
+			casted := sut.(*adjustableMaxConcurrency)
+			Expect(casted.parent).Should(BeIdenticalTo(parent))
+		})
+
+		It("uses the limit", func() {
+			Expect(sutLimit.Limit()).Should(BeNumerically("==", 1))
+		})
+	})
+
+	Describe("SetLimit", func() {
+		It("starts waiting jobs when raised", func(testCtx context.Context) {
+			events := make(chan string)
+
+			sut.Go(func(ctx context.Context) error {
+				events <- "job 1 start"
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, events).Should(Receive(Equal("job 1 start")))
+
+			sut.Go(func(ctx context.Context) error {
+				events <- "job 2 start"
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Consistently(events, 20*time.Millisecond).ShouldNot(Receive())
+
+			sutLimit.SetLimit(2)
+			Expect(sutLimit.Limit()).Should(BeNumerically("==", 2))
+
+			Eventually(testCtx, events).Should(Receive(Equal("job 2 start")))
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("removes the limit when set to NoConcurrencyLimit", func(testCtx context.Context) {
+			const nJobs = 10
+
+			sutLimit.SetLimit(NoConcurrencyLimit)
+			Expect(sutLimit.Limit()).Should(BeNumerically("==", NoConcurrencyLimit))
+
+			started := make(chan struct{})
+
+			for i := 0; i < nJobs; i++ {
+				sut.Go(func(ctx context.Context) error {
+					started <- struct{}{}
+
+					return blockUntilCtxDone(ctx)
+				})
+			}
+
+			for i := 0; i < nJobs; i++ {
+				Eventually(testCtx, started).Should(Receive())
+			}
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+})
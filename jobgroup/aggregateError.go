@@ -0,0 +1,52 @@
+package jobgroup
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AggregateError is returned by groups with an `ErrorPolicy` when jobs failed.
+//
+// It wraps the errors kept by the policy, so `errors.Is` and `errors.As` match any of them.
+type AggregateError struct {
+	errs  []error
+	count uint
+}
+
+func newAggregateError(errs []error, count uint) *AggregateError {
+	return &AggregateError{errs: errs, count: count}
+}
+
+// Error implements `error`.
+func (e *AggregateError) Error() string {
+	var b strings.Builder
+
+	for i, err := range e.errs {
+		if i != 0 {
+			b.WriteByte('\n')
+		}
+
+		b.WriteString(err.Error())
+	}
+
+	if dropped := e.count - uint(len(e.errs)); dropped != 0 {
+		fmt.Fprintf(&b, "\n(and %d more error(s))", dropped)
+	}
+
+	return b.String()
+}
+
+// Unwrap implements the interface expected by `errors.Is` and `errors.As`.
+func (e *AggregateError) Unwrap() []error {
+	return e.errs
+}
+
+// Errors returns the errors that were kept, in the order they were saved.
+func (e *AggregateError) Errors() []error {
+	return e.errs
+}
+
+// Count returns the total number of errors, including those that were not kept.
+func (e *AggregateError) Count() uint {
+	return e.count
+}
@@ -0,0 +1,58 @@
+package jobgroup
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AggregateError", func() {
+	var errs []error
+
+	BeforeEach(func() {
+		errs = []error{
+			errors.New("test error 1"),
+			errors.New("test error 2"),
+		}
+	})
+
+	Describe("Error", func() {
+		It("contains each error", func() {
+			sut := newAggregateError(errs, 2)
+
+			Expect(sut.Error()).Should(Equal("test error 1\ntest error 2"))
+		})
+
+		It("mentions dropped errors", func() {
+			sut := newAggregateError(errs, 5)
+
+			Expect(sut.Error()).Should(HaveSuffix("(and 3 more error(s))"))
+		})
+	})
+
+	Describe("Unwrap", func() {
+		It("returns the errors", func() {
+			sut := newAggregateError(errs, 5)
+
+			Expect(sut.Unwrap()).Should(Equal(errs))
+			Expect(sut).Should(MatchError(errs[1]))
+		})
+	})
+
+	Describe("Errors", func() {
+		It("returns the errors", func() {
+			sut := newAggregateError(errs, 5)
+
+			Expect(sut.Errors()).Should(Equal(errs))
+		})
+	})
+
+	Describe("Count", func() {
+		It("returns the total count", func() {
+			sut := newAggregateError(errs, 5)
+
+			Expect(sut.Count()).Should(BeNumerically("==", 5))
+		})
+	})
+})
@@ -19,6 +19,10 @@ func (g *cancelOnErr) Go(job Job) {
 	g.launch(bindJob(g, job))
 }
 
+func (g *cancelOnErr) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithCancelOnError"
+}
+
 func (g *cancelOnErr) launch(job *boundJob) {
 	job.Wrap(func(userJob Job) Job {
 		return func(ctx context.Context) error {
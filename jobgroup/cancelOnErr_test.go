@@ -26,6 +26,14 @@ var _ = Describe("cancelOnErr", func() {
 
 		sutParent = NewMockjobGroup(ctrl)
 
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
 		sutParent.EXPECT().
 			Ctx().
 			Return(sutParentCtx)
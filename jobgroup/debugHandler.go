@@ -0,0 +1,190 @@
+package jobgroup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DebugHandler returns an `http.Handler` that renders the live hierarchy of `group`, using `Inspect`.
+//
+// It is meant to be served next to `net/http/pprof`, for example:
+//
+//	http.Handle("/debug/jobgroups", jobgroup.DebugHandler(group))
+//
+// The output is plain text by default, or JSON when the `format` query parameter is "json".
+// For each group, it shows the kind, concurrency limit and usage, the number of running and queued jobs,
+// and the age of the oldest running job, followed by its jobs and child groups.
+func DebugHandler(group JobGroup) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := newDebugGroup(group.Inspect(), time.Now())
+
+		switch format := r.URL.Query().Get("format"); format {
+		case "", "text":
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+			report.writeText(w, 0)
+
+		case "json":
+			w.Header().Set("Content-Type", "application/json")
+
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+
+			_ = enc.Encode(report) // nothing to do if the client is gone
+
+		default:
+			http.Error(w, fmt.Sprintf("unknown format: %q", format), http.StatusBadRequest)
+		}
+	})
+}
+
+// debugGroup is the report of a group rendered by `DebugHandler`.
+type debugGroup struct {
+	Kind        string            `json:"kind"`
+	Concurrency *debugConcurrency `json:"concurrency,omitempty"`
+
+	Running int `json:"running"`
+	Queued  int `json:"queued"`
+
+	// OldestRunning is the age of the oldest running job, in seconds.
+	OldestRunning float64 `json:"oldest_running_seconds"`
+
+	Jobs     []debugJob   `json:"jobs"`
+	Children []debugGroup `json:"children"`
+}
+
+// debugConcurrency is the report of a group's concurrency usage rendered by `DebugHandler`.
+type debugConcurrency struct {
+	Limit uint `json:"limit"` // `NoConcurrencyLimit` if the group has no limit
+	InUse uint `json:"in_use"`
+}
+
+// debugJob is the report of a job rendered by `DebugHandler`.
+type debugJob struct {
+	Name   string    `json:"name"`
+	Labels JobLabels `json:"labels,omitempty"`
+	Queued bool      `json:"queued"`
+
+	// Age is the time since the job started running, or was submitted if it is queued, in seconds.
+	Age float64 `json:"age_seconds"`
+}
+
+func newDebugGroup(snapshot GroupSnapshot, now time.Time) debugGroup {
+	res := debugGroup{
+		Kind:        snapshot.Kind,
+		Concurrency: nil, // see below
+
+		Running:       0,   // see below
+		Queued:        0,   // see below
+		OldestRunning: 0,   // see below
+		Jobs:          nil, // see below
+		Children:      nil, // see below
+	}
+
+	if snapshot.Concurrency != nil {
+		res.Concurrency = &debugConcurrency{
+			Limit: snapshot.Concurrency.Limit,
+			InUse: snapshot.Concurrency.InUse,
+		}
+	}
+
+	res.Jobs = make([]debugJob, 0, len(snapshot.Jobs))
+
+	for _, job := range snapshot.Jobs {
+		since := job.Started
+
+		if job.Waiting() {
+			since = job.Submitted
+			res.Queued++
+		} else {
+			res.Running++
+		}
+
+		age := now.Sub(since).Seconds()
+
+		if !job.Waiting() && age > res.OldestRunning {
+			res.OldestRunning = age
+		}
+
+		res.Jobs = append(res.Jobs, debugJob{
+			Name:   job.Name,
+			Labels: job.Labels,
+			Queued: job.Waiting(),
+			Age:    age,
+		})
+	}
+
+	res.Children = make([]debugGroup, 0, len(snapshot.Children))
+
+	for _, child := range snapshot.Children {
+		res.Children = append(res.Children, newDebugGroup(child, now))
+	}
+
+	return res
+}
+
+// writeText writes the report as an indented tree.
+func (g *debugGroup) writeText(w io.Writer, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	fmt.Fprintf(w, "%s%s:", indent, g.Kind)
+
+	if g.Concurrency != nil {
+		if g.Concurrency.Limit == NoConcurrencyLimit {
+			fmt.Fprintf(w, " limit=none in_use=%d", g.Concurrency.InUse)
+		} else {
+			fmt.Fprintf(w, " limit=%d in_use=%d", g.Concurrency.Limit, g.Concurrency.InUse)
+		}
+	}
+
+	fmt.Fprintf(w, " running=%d queued=%d", g.Running, g.Queued)
+
+	if g.Running != 0 {
+		fmt.Fprintf(w, " oldest_running=%s", secondsToDuration(g.OldestRunning))
+	}
+
+	fmt.Fprintln(w)
+
+	for _, job := range g.Jobs {
+		state := "running"
+		if job.Queued {
+			state = "queued"
+		}
+
+		fmt.Fprintf(w, "%s  - %q%s %s for %s\n", indent, job.Name, formatLabels(job.Labels), state, secondsToDuration(job.Age))
+	}
+
+	for i := range g.Children {
+		g.Children[i].writeText(w, depth+1)
+	}
+}
+
+// formatLabels formats `labels` as `{key="value", ...}`, sorted by key.
+func formatLabels(labels JobLabels) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", key, labels[key]))
+	}
+
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond)
+}
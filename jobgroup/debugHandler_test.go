@@ -0,0 +1,113 @@
+package jobgroup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DebugHandler", func() {
+	var (
+		group JobGroup
+		sut   http.Handler
+	)
+
+	BeforeEach(func() {
+		group, _ = WithContext(context.Background())
+		DeferCleanup(group.Close)
+
+		sut = DebugHandler(group)
+	})
+
+	serve := func(url string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+
+		sut.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+
+		return rec
+	}
+
+	When("there are jobs", func() {
+		BeforeEach(func(testCtx context.Context) {
+			child := WithMaxConcurrency(group, 1)
+			DeferCleanup(func() {
+				child.Cancel()
+				Expect(child.Wait()).Should(HaveOccurred()) // the queued job could not start
+			})
+
+			started := make(chan struct{})
+
+			child.GoNamed("running", JobLabels{"key": "value"}, func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			child.GoNamed("queued", nil, func(ctx context.Context) error {
+				return nil
+			})
+		}, NodeTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("renders the tree as text", func() {
+			rec := serve("/debug/jobgroups")
+
+			Expect(rec.Code).Should(Equal(http.StatusOK))
+			Expect(rec.Header().Get("Content-Type")).Should(HavePrefix("text/plain"))
+
+			body := rec.Body.String()
+			Expect(body).Should(HavePrefix("WithContext: running=0 queued=0\n"))
+			Expect(body).Should(MatchRegexp(`\n  WithMaxConcurrency: limit=1 in_use=1 running=1 queued=1 oldest_running=\S+\n`))
+			Expect(body).Should(MatchRegexp(`\n    - "running"\{key="value"\} running for \S+\n`))
+			Expect(body).Should(MatchRegexp(`\n    - "queued" queued for \S+\n`))
+		})
+
+		It("renders the tree as JSON", func() {
+			rec := serve("/debug/jobgroups?format=json")
+
+			Expect(rec.Code).Should(Equal(http.StatusOK))
+			Expect(rec.Header().Get("Content-Type")).Should(Equal("application/json"))
+
+			var report debugGroup
+			Expect(json.Unmarshal(rec.Body.Bytes(), &report)).Should(Succeed())
+
+			Expect(report.Kind).Should(Equal("WithContext"))
+			Expect(report.Concurrency).Should(BeNil())
+			Expect(report.Children).Should(HaveLen(1))
+
+			child := report.Children[0]
+			Expect(child.Kind).Should(Equal("WithMaxConcurrency"))
+			Expect(child.Concurrency).Should(Equal(&debugConcurrency{Limit: 1, InUse: 1}))
+			Expect(child.Running).Should(Equal(1))
+			Expect(child.Queued).Should(Equal(1))
+			Expect(child.OldestRunning).Should(BeNumerically(">", 0))
+
+			Expect(child.Jobs).Should(HaveLen(2))
+			Expect(child.Jobs[0].Name).Should(Equal("running"))
+			Expect(child.Jobs[0].Labels).Should(Equal(JobLabels{"key": "value"}))
+			Expect(child.Jobs[0].Queued).Should(BeFalse())
+			Expect(child.Jobs[1].Name).Should(Equal("queued"))
+			Expect(child.Jobs[1].Queued).Should(BeTrue())
+		})
+	})
+
+	It("reports groups without a limit", func() {
+		child := WithKeyedSerialization[string](group)
+		defer child.Close()
+
+		Expect(serve("/").Body.String()).Should(ContainSubstring("WithKeyedConcurrency: limit=none in_use=0 running=0 queued=0\n"))
+	})
+
+	It("rejects unknown formats", func() {
+		rec := serve("/?format=xml")
+
+		Expect(rec.Code).Should(Equal(http.StatusBadRequest))
+		Expect(rec.Body.String()).Should(ContainSubstring(`unknown format: "xml"`))
+	})
+})
@@ -0,0 +1,72 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+)
+
+// NoErrorLimit is used to signify all job errors should be kept.
+const NoErrorLimit = 0
+
+// ErrorPolicy defines which job errors a group keeps.
+//
+// Errors that are not kept are still counted, see `AggregateError`.
+type ErrorPolicy struct {
+	// MaxErrors is the maximum number of errors kept: once it is reached, new errors are dropped.
+	// For example, 1 only keeps the first error.
+	//
+	// If it is `NoErrorLimit`, errors are not limited.
+	MaxErrors uint
+
+	// Deduplicate is a list of targets for `errors.Is`: only the first error matching
+	// each target is kept.
+	Deduplicate []error
+}
+
+type errorPolicyCtxKeyType struct{}
+
+var errorPolicyCtxKey = new(errorPolicyCtxKeyType) //nolint:gochecknoglobals
+
+// WithErrorPolicy returns a new `JobGroup`, child of `parent`, that keeps job errors according to `policy`.
+//
+// Instead of joining all errors, `Wait` returns an `AggregateError` that provides the total number of errors.
+// Errors from child groups are unpacked, so the policy applies to each of them, and counts stay accurate.
+//
+// Like `WithPanicPolicy`, the policy is inherited by child groups, which can set a different one.
+func WithErrorPolicy(parent JobGroup, policy ErrorPolicy) JobGroup {
+	return withParentAndContext(parent, context.WithValue(parent.Ctx(), errorPolicyCtxKey, &policy))
+}
+
+func errorPolicyFromCtx(ctx context.Context) *ErrorPolicy {
+	policy, ok := ctx.Value(errorPolicyCtxKey).(*ErrorPolicy)
+	if !ok {
+		return nil
+	}
+
+	return policy
+}
+
+// save counts `err`, and keeps it if allowed by the policy.
+func (p *ErrorPolicy) save(data *failuresData, err error) {
+	data.errCount++
+
+	if p.MaxErrors != NoErrorLimit && uint(len(data.err)) >= p.MaxErrors {
+		return
+	}
+
+	if data.dedupMatched == nil && len(p.Deduplicate) != 0 {
+		data.dedupMatched = make([]bool, len(p.Deduplicate))
+	}
+
+	for i, target := range p.Deduplicate {
+		if errors.Is(err, target) {
+			if data.dedupMatched[i] {
+				return
+			}
+
+			data.dedupMatched[i] = true
+		}
+	}
+
+	data.err = append(data.err, err)
+}
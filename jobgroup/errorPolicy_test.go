@@ -0,0 +1,139 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ErrorPolicy", func() {
+	var (
+		root JobGroup
+
+		sut       JobGroup
+		sutPolicy ErrorPolicy
+	)
+
+	BeforeEach(func() {
+		root, _ = WithContext(context.Background())
+		DeferCleanup(root.Close)
+
+		sutPolicy = ErrorPolicy{
+			MaxErrors:   NoErrorLimit,
+			Deduplicate: nil,
+		}
+	})
+
+	JustBeforeEach(func() {
+		sut = WithErrorPolicy(root, sutPolicy)
+		DeferCleanup(sut.Close)
+	})
+
+	// failJobs runs `n` jobs, each returning the error returned by `mkErr`, and waits for them.
+	failJobs := func(ctx context.Context, group JobGroup, n int, mkErr func(i int) error) *AggregateError {
+		for i := 0; i < n; i++ {
+			err := mkErr(i)
+
+			group.Go(func(ctx context.Context) error {
+				return err
+			})
+		}
+
+		err, ok := group.WaitCtx(ctx)
+		Expect(ok).Should(BeTrue())
+
+		var agg *AggregateError
+		Expect(errors.As(err, &agg)).Should(BeTrue())
+
+		return agg
+	}
+
+	Describe("WithErrorPolicy", func() {
+		It("creates a child group", func() {
+			casted := sut.(*withParent)
+			Expect(casted.parent).Should(BeIdenticalTo(root))
+		})
+
+		It("returns nil when no job fails", func(testCtx context.Context) {
+			sut.Go(func(ctx context.Context) error {
+				return nil
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("keeps all errors by default", func(testCtx context.Context) {
+			agg := failJobs(testCtx, sut, 10, func(i int) error { return fmt.Errorf("test error %d", i) })
+
+			Expect(agg.Errors()).Should(HaveLen(10))
+			Expect(agg.Count()).Should(BeNumerically("==", 10))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	When("there is a max", func() {
+		BeforeEach(func() {
+			sutPolicy.MaxErrors = 3
+		})
+
+		It("only keeps the first errors", func(testCtx context.Context) {
+			agg := failJobs(testCtx, sut, 10, func(i int) error { return fmt.Errorf("test error %d", i) })
+
+			Expect(agg.Errors()).Should(HaveLen(3))
+			Expect(agg.Count()).Should(BeNumerically("==", 10))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("applies to errors of child groups", func(testCtx context.Context) {
+			sut.Go(func(ctx context.Context) error {
+				return errors.New("sut error")
+			})
+
+			child := WithParent(sut)
+
+			for i := 0; i < 10; i++ {
+				child.Go(func(ctx context.Context) error {
+					return errors.New("child error")
+				})
+			}
+
+			child.Close() // propagates an `AggregateError` to `sut`
+
+			agg := failJobs(testCtx, sut, 0, nil)
+			Expect(agg.Errors()).Should(HaveLen(3))
+			Expect(agg.Count()).Should(BeNumerically("==", 11))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	When("there are deduplication targets", func() {
+		var target1, target2 error
+
+		BeforeEach(func() {
+			target1 = errors.New("target 1")
+			target2 = errors.New("target 2")
+
+			sutPolicy.Deduplicate = []error{target1, target2}
+		})
+
+		It("keeps one error per target", func(testCtx context.Context) {
+			agg := failJobs(testCtx, sut, 10, func(i int) error {
+				switch i % 3 {
+				case 0:
+					return fmt.Errorf("job %d: %w", i, target1)
+				case 1:
+					return fmt.Errorf("job %d: %w", i, target2)
+				default:
+					return fmt.Errorf("job %d", i)
+				}
+			})
+
+			Expect(agg.Errors()).Should(HaveLen(2 + 3))
+			Expect(agg.Count()).Should(BeNumerically("==", 10))
+			Expect(agg).Should(SatisfyAll(MatchError(target1), MatchError(target2)))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+})
@@ -0,0 +1,66 @@
+package jobgroup
+
+import (
+	"encoding/json"
+	"expvar"
+)
+
+// PublishExpvar publishes the `Stats` of `group` using `expvar`, so they are served by `/debug/vars`.
+//
+// The stats are read each time the variable is, so they are always up to date.
+// Like `expvar.Publish`, it panics if `name` is already used. Variables cannot be unpublished,
+// so this is meant for groups that live as long as the program.
+func PublishExpvar(name string, group JobGroup) {
+	expvar.Publish(name, expvar.Func(func() any {
+		return group.Stats()
+	}))
+}
+
+// MarshalJSON implements `json.Marshaler`. Durations are in seconds.
+func (s GroupStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Started    uint64 `json:"started"`
+		Completed  uint64 `json:"completed"`
+		Failed     uint64 `json:"failed"`
+		Panicked   uint64 `json:"panicked"`
+		NotStarted uint64 `json:"not_started"`
+		Running    uint64 `json:"running"`
+		Waiting    uint64 `json:"waiting"`
+
+		WaitTime  float64           `json:"wait_time_seconds"`
+		WaitTimes DurationHistogram `json:"wait_times"`
+		RunTimes  DurationHistogram `json:"run_times"`
+	}{
+		Started:    s.Started,
+		Completed:  s.Completed,
+		Failed:     s.Failed,
+		Panicked:   s.Panicked,
+		NotStarted: s.NotStarted,
+		Running:    s.Running,
+		Waiting:    s.Waiting,
+
+		WaitTime:  s.WaitTime.Seconds(),
+		WaitTimes: s.WaitTimes,
+		RunTimes:  s.RunTimes,
+	})
+}
+
+// MarshalJSON implements `json.Marshaler`. Durations are in seconds.
+func (h DurationHistogram) MarshalJSON() ([]byte, error) {
+	buckets := make([]float64, 0, len(h.Buckets))
+	for _, bucket := range h.Buckets {
+		buckets = append(buckets, bucket.Seconds())
+	}
+
+	return json.Marshal(struct {
+		Buckets []float64 `json:"buckets_seconds"`
+		Counts  []uint64  `json:"counts"`
+		Count   uint64    `json:"count"`
+		Sum     float64   `json:"sum_seconds"`
+	}{
+		Buckets: buckets,
+		Counts:  h.Counts,
+		Count:   h.Count,
+		Sum:     h.Sum.Seconds(),
+	})
+}
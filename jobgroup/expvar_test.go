@@ -0,0 +1,68 @@
+package jobgroup
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PublishExpvar", func() {
+	var group JobGroup
+
+	BeforeEach(func() {
+		group, _ = WithContext(context.Background())
+		DeferCleanup(group.Close)
+	})
+
+	It("publishes up to date stats", func(testCtx context.Context) {
+		PublishExpvar("jobgroup_test_group", group)
+
+		read := func() map[string]any {
+			var stats map[string]any
+			Expect(json.Unmarshal([]byte(expvar.Get("jobgroup_test_group").String()), &stats)).Should(Succeed())
+
+			return stats
+		}
+
+		Expect(read()).Should(HaveKeyWithValue("started", BeNumerically("==", 0)))
+
+		group.Go(func(ctx context.Context) error {
+			return nil
+		})
+
+		err, ok := group.WaitCtx(testCtx)
+		Expect(ok).Should(BeTrue())
+		Expect(err).Should(Succeed())
+
+		stats := read()
+		Expect(stats).Should(HaveKeyWithValue("started", BeNumerically("==", 1)))
+		Expect(stats).Should(HaveKeyWithValue("completed", BeNumerically("==", 1)))
+		Expect(stats).Should(HaveKeyWithValue("run_times", HaveKeyWithValue("count", BeNumerically("==", 1))))
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	Describe("MarshalJSON", func() {
+		It("uses seconds for durations", func() {
+			stats := GroupStats{ //nolint:exhaustruct
+				WaitTime: 1500 * time.Millisecond,
+				RunTimes: DurationHistogram{
+					Buckets: []time.Duration{time.Second},
+					Counts:  []uint64{1},
+					Count:   2,
+					Sum:     3 * time.Second,
+				},
+			}
+
+			out, err := json.Marshal(stats)
+			Expect(err).Should(Succeed())
+
+			Expect(string(out)).Should(ContainSubstring(`"wait_time_seconds":1.5`))
+			Expect(string(out)).Should(ContainSubstring(
+				`"run_times":{"buckets_seconds":[1],"counts":[1],"count":2,"sum_seconds":3}`,
+			))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package jobgroup
+
+import (
+	"context"
+)
+
+var _ jobGroup = (*fairMaxConcurrency)(nil)
+
+type fairMaxConcurrency struct {
+	withParent
+
+	sem *semaphore
+}
+
+// WithFairMaxConcurrency is like `WithMaxConcurrency`, but jobs start in the order they were submitted.
+//
+// A job's place in the queue is taken when `Go` is called, so jobs submitted sequentially by
+// a goroutine start in that order.
+func WithFairMaxConcurrency(parent JobGroup, max uint) JobGroup {
+	if max == NoConcurrencyLimit {
+		return WithParent(parent)
+	}
+
+	return initGroup(parent.Ctx(), &fairMaxConcurrency{
+		withParent: newWithParent(parent),
+
+		sem: newSemaphore(max),
+	})
+}
+
+func (g *fairMaxConcurrency) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *fairMaxConcurrency) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithFairMaxConcurrency"
+	snapshot.Concurrency = g.sem.usage()
+}
+
+func (g *fairMaxConcurrency) admit(ctx context.Context, job *boundJob) error {
+	ticket, err := g.sem.acquire(ctx, DefaultPriority, DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	err = g.withParent.admit(ctx, job)
+	if err != nil {
+		ticket.leave()
+
+		return err
+	}
+
+	job.Defer(ticket.leave)
+
+	return nil
+}
+
+func (g *fairMaxConcurrency) launch(job *boundJob) {
+	g.launchWith(job, DefaultPriority, DefaultCost)
+}
+
+func (g *fairMaxConcurrency) launchWith(job *boundJob, priority int, cost uint) {
+	if !job.admitted { // otherwise the slot was taken by `admit`
+		// Queue from the current goroutine to keep submission order.
+		ticket := g.sem.enqueue(priority, cost)
+		job.Defer(ticket.leave)
+
+		job.Acquire(ticket.wait)
+	}
+
+	g.withParent.launch(job)
+}
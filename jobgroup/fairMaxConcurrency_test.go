@@ -0,0 +1,269 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ThinkChaos/parcour/zync"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("fairMaxConcurrency", func() {
+	var (
+		sutParent     *MockjobGroup
+		sutParentCtx  *identifiableContext
+		sutParentImpl jobGroup
+
+		sut      *fairMaxConcurrency
+		sutLimit uint
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+
+		sutParentCtx = newIdentifiableContext(context.Background())
+		DeferCleanup(sutParentCtx.Cancel)
+
+		sutParent = NewMockjobGroup(ctrl)
+
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
+		sutParent.EXPECT().
+			Ctx().
+			Return(sutParentCtx)
+
+		group, _ := WithContext(sutParentCtx)
+		DeferCleanup(group.Close)
+
+		sutParentImpl = downcastGroup(group)
+
+		sutLimit = 1
+	})
+
+	JustBeforeEach(func() {
+		sut = WithFairMaxConcurrency(sutParent, sutLimit).(*fairMaxConcurrency)
+		Expect(sut).ShouldNot(BeNil())
+		Expect(sut.parent).ShouldNot(BeNil())
+		Expect(sut.parent).Should(BeIdenticalTo(sutParent))
+
+		DeferCleanup(sut.Close)
+	})
+
+	Describe("WithFairMaxConcurrency", func() {
+		When("the limit is `NoConcurrencyLimit`", func() {
+			It("should not limit concurrency", func() {
+				sutParent.EXPECT().
+					Ctx().
+					Return(sutParentCtx)
+
+				group := WithFairMaxConcurrency(sutParent, NoConcurrencyLimit)
+				defer group.Close()
+
+				casted := group.(*withParent)
+				Expect(casted).ShouldNot(BeNil())
+				Expect(casted.parent).Should(BeIdenticalTo(sutParent))
+			})
+		})
+	})
+
+	Describe("Go", func() {
+		It("starts jobs in submission order under contention", func(testCtx context.Context) {
+			const nJobs = 100
+
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				AnyTimes().
+				Do(sutParentImpl.launch)
+
+			blockCtx, unblock := context.WithCancel(testCtx)
+			started := make(chan struct{})
+
+			sut.Go(func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(blockCtx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			order := zync.NewMutex([]int(nil))
+			expected := make([]int, 0, nJobs)
+
+			for i := 0; i < nJobs; i++ {
+				i := i
+
+				expected = append(expected, i)
+
+				sut.Go(func(ctx context.Context) error {
+					order.WithLock(func(order *[]int) {
+						*order = append(*order, i)
+					})
+
+					return nil
+				})
+			}
+
+			// Give all job goroutines time to be blocked
+			Consistently(func() []int {
+				val, unlock := order.Lock()
+				defer unlock()
+
+				return *val
+			}, 20*time.Millisecond).Should(BeEmpty())
+
+			unblock()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			order.WithLock(func(order *[]int) {
+				Expect(*order).Should(Equal(expected))
+			})
+		}, SpecTimeout(time.Second*timeoutFactor))
+
+		When("the limit is greater than 1", func() {
+			BeforeEach(func() {
+				sutLimit = 3
+			})
+
+			It("runs jobs concurrently", func(testCtx context.Context) {
+				sutParent.EXPECT().
+					launch(gomock.Any()).
+					Times(int(sutLimit)).
+					Do(sutParentImpl.launch)
+
+				started := make(chan struct{})
+
+				for i := uint(0); i < sutLimit; i++ {
+					sut.Go(func(ctx context.Context) error {
+						started <- struct{}{}
+
+						return blockUntilCtxDone(ctx)
+					})
+				}
+
+				for i := uint(0); i < sutLimit; i++ {
+					Eventually(testCtx, started).Should(Receive())
+				}
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(Succeed())
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+
+		It("stops waiting if the group is cancelled", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Times(2).
+				Do(sutParentImpl.launch)
+
+			started := make(chan struct{})
+
+			sut.Go(func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			sut.Go(func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Fail("job 2 should never run")
+
+				return nil
+			})
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue()) // false is test timeout
+			Expect(errors.As(err, new(*JobNotStartedError))).Should(BeTrue())
+
+			By("leaving the queue", func() {
+				sut.sem.state.WithLock(func(state *semaphoreState) {
+					Expect(state.used).Should(BeZero())
+					Expect(state.waiters).Should(BeEmpty())
+				})
+			})
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	Describe("TryGo", func() {
+		It("refuses the job when the limit is reached", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				admit(gomock.Any(), gomock.Any()).
+				DoAndReturn(sutParentImpl.admit)
+
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			started := make(chan struct{})
+
+			Expect(sut.TryGo(func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(ctx)
+			})).Should(BeTrue())
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			Expect(sut.TryGo(func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Fail("job should never run")
+
+				return nil
+			})).Should(BeFalse())
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			sut.sem.state.WithLock(func(state *semaphoreState) {
+				Expect(state.used).Should(BeZero())
+				Expect(state.waiters).Should(BeEmpty())
+			})
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	DescribeTable("doesn't deadlock under a saturated parent",
+		func(testCtx context.Context, newGroup func(parent JobGroup) JobGroup) {
+			expectNoDeadlockUnderSaturatedParent(testCtx, newGroup)
+		},
+		Entry("WithFairMaxConcurrency", func(parent JobGroup) JobGroup {
+			return WithFairMaxConcurrency(parent, 1)
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor)),
+		Entry("WithPriorityMaxConcurrency", func(parent JobGroup) JobGroup {
+			return WithPriorityMaxConcurrency(parent, 1)
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor)),
+		Entry("WithWeightedMaxConcurrency", func(parent JobGroup) JobGroup {
+			return WithWeightedMaxConcurrency(parent, 1)
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor)),
+		Entry("WithAdaptiveConcurrency", func(parent JobGroup) JobGroup {
+			return WithAdaptiveConcurrency(parent, AdaptiveConcurrency{Max: 1}) //nolint:exhaustruct
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor)),
+		Entry("WithAdjustableMaxConcurrency", func(parent JobGroup) JobGroup {
+			group, _ := WithAdjustableMaxConcurrency(parent, 1)
+
+			return group
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor)),
+	)
+})
@@ -0,0 +1,68 @@
+package jobgroup
+
+import (
+	"context"
+)
+
+// Future is the eventual result of a job started with `GoResult`.
+type Future[T any] struct {
+	done chan struct{}
+
+	value T
+	err   error
+}
+
+// GoResult starts a job that produces a value as part of `group`, and returns a `Future` for that value.
+//
+// The job is a regular job of `group`: the group waits for it, and its error is handled by the group
+// like the error of any other job, in addition to being available from the `Future`.
+func GoResult[T any](group JobGroup, job func(context.Context) (T, error)) *Future[T] {
+	future := &Future[T]{
+		done: make(chan struct{}),
+	}
+
+	casted := downcastGroup(group)
+
+	bound := bindJob(casted, func(ctx context.Context) error {
+		var err error
+
+		future.value, err = job(ctx)
+
+		return err
+	})
+
+	// Using `Defer` ensures the future is resolved even if the job never ran.
+	bound.Defer(func() {
+		future.err = bound.result
+
+		close(future.done)
+	})
+
+	casted.launch(bound)
+
+	return future
+}
+
+// Done returns a channel that's closed once the job ended.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await blocks until the job ended and returns its result.
+//
+// If `ctx` ends first, its error is returned instead. The job keeps running.
+//
+// If the job could not be started, the error is a `JobNotStartedError`.
+// If it panicked, the error is a `JobPanicError`, which matches `ErrJobPanicked`.
+// The panic itself is still propagated by the job's group.
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+
+	case <-ctx.Done():
+		var zero T
+
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,153 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Future", func() {
+	var group JobGroup
+
+	BeforeEach(func() {
+		group, _ = WithContext(context.Background())
+		DeferCleanup(group.Close)
+	})
+
+	Describe("GoResult", func() {
+		It("returns the job's value", func(testCtx context.Context) {
+			sut := GoResult(group, func(ctx context.Context) (int, error) {
+				return 42, nil
+			})
+
+			val, err := sut.Await(testCtx)
+			Expect(err).Should(Succeed())
+			Expect(val).Should(Equal(42))
+
+			Eventually(testCtx, sut.Done()).Should(BeClosed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("returns the job's error, and saves it in the group", func(testCtx context.Context) {
+			expectedErr := errors.New("expected error")
+
+			sut := GoResult(group, func(ctx context.Context) (string, error) {
+				return "", expectedErr
+			})
+
+			_, err := sut.Await(testCtx)
+			Expect(err).Should(MatchError(expectedErr))
+
+			err, ok := group.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(MatchError(expectedErr))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("is waited on by the group", func(testCtx context.Context) {
+			jobCtx, jobEnd := context.WithCancel(testCtx)
+
+			sut := GoResult(group, func(ctx context.Context) (int, error) {
+				return 1, blockUntilCtxDone(jobCtx)
+			})
+
+			waitCtx, cancel := context.WithTimeout(testCtx, 20*time.Millisecond)
+			defer cancel()
+
+			_, ok := group.WaitCtx(waitCtx)
+			Expect(ok).Should(BeFalse())
+			Expect(sut.Done()).ShouldNot(BeClosed())
+
+			jobEnd()
+
+			err, ok := group.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+			Expect(sut.Done()).Should(BeClosed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("uses the group's concurrency limit", func(testCtx context.Context) {
+			limited := WithMaxConcurrency(group, 1)
+			defer limited.Close()
+
+			jobCtx, jobEnd := context.WithCancel(testCtx)
+			started := make(chan struct{})
+
+			limited.Go(func(context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(jobCtx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			sut := GoResult(limited, func(ctx context.Context) (int, error) {
+				return 1, nil
+			})
+
+			Consistently(sut.Done(), 20*time.Millisecond).ShouldNot(BeClosed())
+
+			jobEnd()
+
+			val, err := sut.Await(testCtx)
+			Expect(err).Should(Succeed())
+			Expect(val).Should(Equal(1))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("resolves when the job is not started", func(testCtx context.Context) {
+			group.Cancel()
+
+			sut := GoResult(group, func(ctx context.Context) (int, error) {
+				defer GinkgoRecover()
+
+				Fail("job should not run")
+
+				return 1, nil
+			})
+
+			_, err := sut.Await(testCtx)
+			Expect(errors.As(err, new(*JobNotStartedError))).Should(BeTrue())
+
+			err, ok := group.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(MatchError(context.Canceled))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("resolves when the job panics", func(testCtx context.Context) {
+			const expectedVal = "panic value"
+
+			sut := GoResult(group, func(ctx context.Context) (int, error) {
+				panic(expectedVal)
+			})
+
+			_, err := sut.Await(testCtx)
+			Expect(err).Should(MatchError(ErrJobPanicked))
+
+			Expect(func() { group.WaitCtx(testCtx) }).To(PanicWith(HaveField("Value()", expectedVal)))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	Describe("Await", func() {
+		It("returns when the context ends", func(testCtx context.Context) {
+			started := make(chan struct{})
+
+			sut := GoResult(group, func(ctx context.Context) (int, error) {
+				close(started)
+
+				return 1, blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			ctx, cancel := context.WithCancel(testCtx)
+			cancel()
+
+			val, err := sut.Await(ctx)
+			Expect(err).Should(MatchError(context.Canceled))
+			Expect(val).Should(BeZero())
+
+			group.Cancel()
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+})
@@ -0,0 +1,61 @@
+package jobgroup
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+// currentGoroutineID returns the ID of the current goroutine, as shown in stack traces.
+//
+// It returns 0 if the ID cannot be determined.
+func currentGoroutineID() uint64 {
+	var buf [64]byte
+
+	// The stack starts with "goroutine 123 [running]:"
+	stack := buf[:runtime.Stack(buf[:], false)]
+
+	return parseGoroutineID(stack)
+}
+
+// goroutineStacks returns the stack of each goroutine, by ID.
+func goroutineStacks() map[uint64]string {
+	buf := make([]byte, 64<<10)
+
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+
+			break
+		}
+
+		buf = make([]byte, 2*len(buf))
+	}
+
+	res := make(map[uint64]string)
+
+	for _, stack := range bytes.Split(buf, []byte("\n\n")) {
+		if id := parseGoroutineID(stack); id != 0 {
+			res[id] = string(stack)
+		}
+	}
+
+	return res
+}
+
+func parseGoroutineID(stack []byte) uint64 {
+	stack = bytes.TrimPrefix(stack, []byte("goroutine "))
+
+	end := bytes.IndexByte(stack, ' ')
+	if end == -1 {
+		return 0
+	}
+
+	id, err := strconv.ParseUint(string(stack[:end]), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
@@ -0,0 +1,28 @@
+package jobgroup
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("goroutine", func() {
+	Describe("currentGoroutineID", func() {
+		It("returns the ID of the current goroutine", func() {
+			id := currentGoroutineID()
+			Expect(id).ShouldNot(BeZero())
+
+			Expect(goroutineStacks()).Should(HaveKeyWithValue(id, ContainSubstring("goroutineStacks")))
+		})
+	})
+
+	Describe("parseGoroutineID", func() {
+		It("parses the stack header", func() {
+			Expect(parseGoroutineID([]byte("goroutine 123 [running]:\nmain.main()"))).Should(BeNumerically("==", 123))
+		})
+
+		It("returns 0 for invalid stacks", func() {
+			Expect(parseGoroutineID([]byte("goroutine"))).Should(BeZero())
+			Expect(parseGoroutineID([]byte("goroutine x [running]:"))).Should(BeZero())
+		})
+	})
+})
@@ -0,0 +1,123 @@
+package jobgroup
+
+import (
+	"github.com/ThinkChaos/parcour/zync"
+)
+
+// GroupSnapshot describes the state of a group and its child groups, see `JobGroup.Inspect`.
+type GroupSnapshot struct {
+	// Kind is the function that created the group, for example "WithMaxConcurrency".
+	//
+	// Groups that only configure their children, such as those of `WithObserver`, are "WithParent".
+	Kind string
+
+	// Jobs are the jobs of the group that are not done yet, oldest first.
+	//
+	// Jobs of child groups are only part of the child's snapshot.
+	Jobs []JobSnapshot
+
+	// Concurrency is the usage of the group's concurrency limit.
+	// It is nil if the group has no limit of its own.
+	Concurrency *ConcurrencySnapshot
+
+	// Children are the snapshots of the child groups that are not closed yet, oldest first.
+	//
+	// This includes groups created using `WithContext` with the context of the group or one of its jobs.
+	Children []GroupSnapshot
+}
+
+// JobSnapshot describes a job that is not done yet.
+type JobSnapshot struct {
+	JobInfo
+}
+
+// Waiting reports whether the job is waiting to start, for example because of a concurrency limit.
+func (s *JobSnapshot) Waiting() bool {
+	return s.Started.IsZero()
+}
+
+// ConcurrencySnapshot describes the usage of a group's concurrency limit.
+type ConcurrencySnapshot struct {
+	// Limit is the limit at the time of the snapshot.
+	// It can be `NoConcurrencyLimit`, for example for groups created by `WithKeyedSerialization`.
+	Limit uint
+
+	// InUse is how much of the limit is used by running jobs.
+	// For a `WeightedJobGroup`, it is the total cost of the running jobs.
+	InUse uint
+}
+
+func (g *withContext) Inspect() GroupSnapshot {
+	snapshot := GroupSnapshot{
+		Kind:        "", // see describe
+		Jobs:        g.running.snapshot(g.self),
+		Concurrency: nil, // see describe
+		Children:    nil, // see below
+	}
+
+	children := g.children.list()
+	if len(children) != 0 {
+		snapshot.Children = make([]GroupSnapshot, 0, len(children))
+
+		for _, child := range children {
+			snapshot.Children = append(snapshot.Children, child.Inspect())
+		}
+	}
+
+	g.self.describe(&snapshot)
+
+	return snapshot
+}
+
+// childGroups tracks the child groups of a group, until they are closed.
+type childGroups struct {
+	zync.Mutex[[]jobGroup] // in creation order
+}
+
+func newChildGroups() childGroups {
+	return childGroups{
+		Mutex: zync.NewMutex[[]jobGroup](nil),
+	}
+}
+
+func (c *childGroups) add(child jobGroup) {
+	c.WithLock(func(children *[]jobGroup) {
+		*children = append(*children, child)
+	})
+}
+
+func (c *childGroups) remove(child jobGroup) {
+	c.WithLock(func(children *[]jobGroup) {
+		for i, other := range *children {
+			if other == child {
+				*children = append((*children)[:i], (*children)[i+1:]...)
+
+				return
+			}
+		}
+	})
+}
+
+func (c *childGroups) list() []jobGroup {
+	var res []jobGroup
+
+	c.WithLock(func(children *[]jobGroup) {
+		res = append(res, *children...)
+	})
+
+	return res
+}
+
+// usage returns the semaphore's usage as a `ConcurrencySnapshot`.
+func (s *semaphore) usage() *ConcurrencySnapshot {
+	var res ConcurrencySnapshot
+
+	s.state.WithLock(func(state *semaphoreState) {
+		res = ConcurrencySnapshot{
+			Limit: semaphoreToLimit(state.limit),
+			InUse: state.used,
+		}
+	})
+
+	return &res
+}
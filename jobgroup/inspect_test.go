@@ -0,0 +1,160 @@
+package jobgroup
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Inspect", func() {
+	var sut JobGroup
+
+	BeforeEach(func() {
+		sut, _ = WithContext(context.Background())
+		DeferCleanup(sut.Close)
+	})
+
+	It("describes the group", func() {
+		snapshot := sut.Inspect()
+
+		Expect(snapshot.Kind).Should(Equal("WithContext"))
+		Expect(snapshot.Jobs).Should(BeEmpty())
+		Expect(snapshot.Concurrency).Should(BeNil())
+		Expect(snapshot.Children).Should(BeEmpty())
+	})
+
+	It("lists running jobs", func(testCtx context.Context) {
+		started := make(chan struct{})
+
+		before := time.Now()
+
+		sut.GoNamed("job", JobLabels{"key": "value"}, func(ctx context.Context) error {
+			close(started)
+
+			return blockUntilCtxDone(ctx)
+		})
+
+		Eventually(testCtx, started).Should(BeClosed())
+
+		jobs := sut.Inspect().Jobs
+		Expect(jobs).Should(HaveLen(1))
+
+		job := jobs[0]
+		Expect(job.Name).Should(Equal("job"))
+		Expect(job.Labels).Should(Equal(JobLabels{"key": "value"}))
+		Expect(job.Submitted).Should(BeTemporally(">=", before))
+		Expect(job.Started).Should(BeTemporally(">=", job.Submitted))
+		Expect(job.Waiting()).Should(BeFalse())
+
+		sut.Cancel()
+
+		Eventually(testCtx, func() []JobSnapshot { return sut.Inspect().Jobs }).Should(BeEmpty())
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	It("lists waiting jobs and concurrency usage", func(testCtx context.Context) {
+		child := WithMaxConcurrency(sut, 1)
+		defer child.Close()
+
+		started := make(chan struct{})
+
+		child.GoNamed("running", nil, func(ctx context.Context) error {
+			close(started)
+
+			return blockUntilCtxDone(ctx)
+		})
+
+		Eventually(testCtx, started).Should(BeClosed())
+
+		child.GoNamed("waiting", nil, func(ctx context.Context) error {
+			return nil
+		})
+
+		snapshot := child.Inspect()
+		Expect(snapshot.Kind).Should(Equal("WithMaxConcurrency"))
+		Expect(snapshot.Concurrency).Should(Equal(&ConcurrencySnapshot{Limit: 1, InUse: 1}))
+
+		Expect(snapshot.Jobs).Should(HaveLen(2))
+		Expect(snapshot.Jobs[0].Name).Should(Equal("running"))
+		Expect(snapshot.Jobs[0].Waiting()).Should(BeFalse())
+		Expect(snapshot.Jobs[1].Name).Should(Equal("waiting"))
+		Expect(snapshot.Jobs[1].Waiting()).Should(BeTrue())
+
+		child.Cancel()
+
+		Expect(child.Wait()).Should(MatchError(ContainSubstring("could not be started")))
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	It("includes child groups until they are closed", func(testCtx context.Context) {
+		child := WithParent(sut)
+
+		started := make(chan struct{})
+
+		child.GoNamed("parent job", nil, func(ctx context.Context) error {
+			// Also include groups created from a job's context
+			group, _ := WithContext(ctx)
+			defer group.Close()
+
+			group.GoNamed("child job", nil, blockUntilCtxDone)
+
+			close(started)
+
+			return blockUntilCtxDone(ctx)
+		})
+
+		Eventually(testCtx, started).Should(BeClosed())
+
+		snapshot := sut.Inspect()
+		Expect(snapshot.Jobs).Should(BeEmpty())
+		Expect(snapshot.Children).Should(HaveLen(1))
+
+		childSnapshot := snapshot.Children[0]
+		Expect(childSnapshot.Kind).Should(Equal("WithParent"))
+		Expect(childSnapshot.Jobs).Should(ConsistOf(HaveField("Name", "parent job")))
+		Expect(childSnapshot.Children).Should(HaveLen(1))
+		Expect(childSnapshot.Children[0].Jobs).Should(ConsistOf(HaveField("Name", "child job")))
+
+		child.Cancel()
+		child.Close()
+
+		Expect(sut.Inspect().Children).Should(BeEmpty())
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	DescribeTable("Kind",
+		func(newGroup func(JobGroup) JobGroup, kind string) {
+			group := newGroup(sut)
+			defer group.Close()
+
+			Expect(group.Inspect().Kind).Should(Equal(kind))
+		},
+		Entry(nil, WithParent, "WithParent"),
+		Entry(nil, WithCancelOnError, "WithCancelOnError"),
+		Entry(nil, func(parent JobGroup) JobGroup { return WithMaxConcurrency(parent, 1) }, "WithMaxConcurrency"),
+		Entry(nil, func(parent JobGroup) JobGroup { return WithFairMaxConcurrency(parent, 1) }, "WithFairMaxConcurrency"),
+		Entry(nil, func(parent JobGroup) JobGroup {
+			group, _ := WithAdjustableMaxConcurrency(parent, 1)
+
+			return group
+		}, "WithAdjustableMaxConcurrency"),
+		Entry(nil, func(parent JobGroup) JobGroup { return WithPriorityMaxConcurrency(parent, 1) }, "WithPriorityMaxConcurrency"),
+		Entry(nil, func(parent JobGroup) JobGroup { return WithWeightedMaxConcurrency(parent, 1) }, "WithWeightedMaxConcurrency"),
+		Entry(nil, func(parent JobGroup) JobGroup {
+			return WithAdaptiveConcurrency(parent, AdaptiveConcurrency{}) //nolint:exhaustruct
+		}, "WithAdaptiveConcurrency"),
+		Entry(nil, func(parent JobGroup) JobGroup { return WithKeyedSerialization[string](parent) }, "WithKeyedConcurrency"),
+		Entry(nil, func(parent JobGroup) JobGroup { return WithRateLimit(parent, time.Second, 1) }, "WithRateLimit"),
+		Entry(nil, func(parent JobGroup) JobGroup { return WithJobTimeout(parent, time.Second) }, "WithJobTimeout"),
+		Entry(nil, func(parent JobGroup) JobGroup { return WithRetry(parent, RetryPolicy{}) }, "WithRetry"), //nolint:exhaustruct
+		Entry(nil, func(parent JobGroup) JobGroup {
+			return WithSupervisor(parent, SupervisorConfig{}) //nolint:exhaustruct
+		}, "WithSupervisor"),
+	)
+
+	It("reports semaphore limits", func() {
+		group := WithKeyedSerialization[string](sut)
+		defer group.Close()
+
+		Expect(group.Inspect().Concurrency).Should(Equal(&ConcurrencySnapshot{Limit: NoConcurrencyLimit, InUse: 0}))
+	})
+})
@@ -0,0 +1,33 @@
+package jobgroup
+
+import "fmt"
+
+// JobError is returned when a job started with `GoNamed` fails.
+type JobError struct {
+	meta  *jobMeta
+	inner error
+}
+
+func newJobError(meta *jobMeta, inner error) *JobError {
+	return &JobError{meta: meta, inner: inner}
+}
+
+// Error implements `error`.
+func (e *JobError) Error() string {
+	return fmt.Sprintf("job %q failed: %v", e.meta.name, e.inner)
+}
+
+// Unwrap implements the interface expected by `errors.Unwrap`.
+func (e *JobError) Unwrap() error {
+	return e.inner
+}
+
+// Name returns the name of the job.
+func (e *JobError) Name() string {
+	return e.meta.name
+}
+
+// Labels returns the labels of the job.
+func (e *JobError) Labels() JobLabels {
+	return e.meta.labels
+}
@@ -0,0 +1,56 @@
+package jobgroup
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobError", func() {
+	var meta *jobMeta
+
+	BeforeEach(func() {
+		meta = &jobMeta{
+			name:   "test job",
+			labels: JobLabels{"key": "value"},
+		}
+	})
+
+	Describe("Error", func() {
+		It("contains the name and inner error", func() {
+			inner := errors.New("test inner error string")
+
+			sut := newJobError(meta, inner)
+
+			Expect(sut.Error()).Should(ContainSubstring(inner.Error()))
+			Expect(sut.Error()).Should(ContainSubstring(meta.name))
+		})
+	})
+
+	Describe("Unwrap", func() {
+		It("returns the inner error", func() {
+			inner := errors.New("test inner error string")
+
+			sut := newJobError(meta, inner)
+
+			Expect(sut.Unwrap()).Should(BeIdenticalTo(inner))
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the name", func() {
+			sut := newJobError(meta, errors.New("test inner error string"))
+
+			Expect(sut.Name()).Should(Equal(meta.name))
+		})
+	})
+
+	Describe("Labels", func() {
+		It("returns the labels", func() {
+			sut := newJobError(meta, errors.New("test inner error string"))
+
+			Expect(sut.Labels()).Should(Equal(meta.labels))
+		})
+	})
+})
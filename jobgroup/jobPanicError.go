@@ -0,0 +1,72 @@
+package jobgroup
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrJobPanicked matches any `JobPanicError` when using `errors.Is`.
+var ErrJobPanicked = errors.New("job panicked")
+
+// JobPanicError describes the panic of a job.
+//
+// Groups propagate job panics by panicking with a `JobPanicError`, or a `JobPanicsError` when multiple
+// jobs panicked.
+type JobPanicError struct {
+	value any
+	stack []byte
+
+	name   string
+	labels JobLabels
+}
+
+func newJobPanicError(value any, stack []byte, name string, labels JobLabels) *JobPanicError {
+	return &JobPanicError{
+		value: value,
+		stack: stack,
+
+		name:   name,
+		labels: labels,
+	}
+}
+
+// Error implements `error`.
+//
+// It includes the stack of the job's goroutine when it panicked, so it is shown if the panic crashes the program.
+func (e *JobPanicError) Error() string {
+	return fmt.Sprintf("job %q panicked: %v\n\n%s", e.name, e.value, e.stack)
+}
+
+// Unwrap implements the interface expected by `errors.Unwrap`.
+//
+// If the panic value is an error, it is returned. Otherwise nil is returned.
+func (e *JobPanicError) Unwrap() error {
+	err, _ := e.value.(error)
+
+	return err
+}
+
+// Is implements the interface expected by `errors.Is` to match `ErrJobPanicked`.
+func (e *JobPanicError) Is(target error) bool {
+	return target == ErrJobPanicked //nolint:errorlint // sentinel comparison
+}
+
+// Value returns the value the job panicked with.
+func (e *JobPanicError) Value() any {
+	return e.value
+}
+
+// Stack returns the stack of the job's goroutine when it panicked.
+func (e *JobPanicError) Stack() []byte {
+	return e.stack
+}
+
+// Name returns the name of the job, see `JobInfo.Name`.
+func (e *JobPanicError) Name() string {
+	return e.name
+}
+
+// Labels returns the labels of the job, if it was started with `GoNamed`.
+func (e *JobPanicError) Labels() JobLabels {
+	return e.labels
+}
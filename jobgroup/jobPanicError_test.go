@@ -0,0 +1,63 @@
+package jobgroup
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobPanicError", func() {
+	var stack []byte
+
+	BeforeEach(func() {
+		stack = []byte("goroutine 1 [running]:\ntest.job()")
+	})
+
+	Describe("Error", func() {
+		It("contains the name, value and stack", func() {
+			sut := newJobPanicError("test panic value", stack, "test job", nil)
+
+			Expect(sut.Error()).Should(ContainSubstring("test job"))
+			Expect(sut.Error()).Should(ContainSubstring("test panic value"))
+			Expect(sut.Error()).Should(ContainSubstring(string(stack)))
+		})
+	})
+
+	Describe("Unwrap", func() {
+		It("returns the value if it is an error", func() {
+			inner := errors.New("test inner error string")
+
+			sut := newJobPanicError(inner, stack, "test job", nil)
+
+			Expect(sut.Unwrap()).Should(BeIdenticalTo(inner))
+		})
+
+		It("returns nil if the value is not an error", func() {
+			sut := newJobPanicError("test panic value", stack, "test job", nil)
+
+			Expect(sut.Unwrap()).Should(BeNil())
+		})
+	})
+
+	Describe("Is", func() {
+		It("matches ErrJobPanicked", func() {
+			sut := newJobPanicError("test panic value", stack, "test job", nil)
+
+			Expect(sut).Should(MatchError(ErrJobPanicked))
+		})
+	})
+
+	Describe("accessors", func() {
+		It("return the panic's details", func() {
+			labels := JobLabels{"key": "value"}
+
+			sut := newJobPanicError("test panic value", stack, "test job", labels)
+
+			Expect(sut.Value()).Should(Equal("test panic value"))
+			Expect(sut.Stack()).Should(Equal(stack))
+			Expect(sut.Name()).Should(Equal("test job"))
+			Expect(sut.Labels()).Should(Equal(labels))
+		})
+	})
+})
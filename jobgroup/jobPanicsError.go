@@ -0,0 +1,47 @@
+package jobgroup
+
+import (
+	"fmt"
+	"strings"
+)
+
+// JobPanicsError is the value a group panics with when multiple jobs panicked.
+//
+// It wraps the `JobPanicError` of each job, so `errors.Is` and `errors.As` match any of them.
+type JobPanicsError struct {
+	panics []*JobPanicError
+}
+
+func newJobPanicsError(panics []*JobPanicError) *JobPanicsError {
+	return &JobPanicsError{panics: panics}
+}
+
+// Error implements `error`.
+func (e *JobPanicsError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d jobs panicked", len(e.panics))
+
+	for _, p := range e.panics {
+		b.WriteString("\n\n")
+		b.WriteString(p.Error())
+	}
+
+	return b.String()
+}
+
+// Unwrap implements the interface expected by `errors.Is` and `errors.As`.
+func (e *JobPanicsError) Unwrap() []error {
+	errs := make([]error, 0, len(e.panics))
+
+	for _, p := range e.panics {
+		errs = append(errs, p)
+	}
+
+	return errs
+}
+
+// Panics returns the panic of each job.
+func (e *JobPanicsError) Panics() []*JobPanicError {
+	return e.panics
+}
@@ -0,0 +1,49 @@
+package jobgroup
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobPanicsError", func() {
+	var panics []*JobPanicError
+
+	BeforeEach(func() {
+		panics = []*JobPanicError{
+			newJobPanicError("test panic value 1", nil, "test job 1", nil),
+			newJobPanicError(errors.New("test panic error 2"), nil, "test job 2", nil),
+		}
+	})
+
+	Describe("Error", func() {
+		It("contains each panic", func() {
+			sut := newJobPanicsError(panics)
+
+			Expect(sut.Error()).Should(ContainSubstring("2 jobs"))
+
+			for _, p := range panics {
+				Expect(sut.Error()).Should(ContainSubstring(p.Error()))
+			}
+		})
+	})
+
+	Describe("Unwrap", func() {
+		It("returns each panic", func() {
+			sut := newJobPanicsError(panics)
+
+			Expect(sut.Unwrap()).Should(HaveLen(2))
+			Expect(sut).Should(MatchError(ErrJobPanicked))
+			Expect(sut).Should(MatchError(panics[1].Unwrap()))
+		})
+	})
+
+	Describe("Panics", func() {
+		It("returns the panics", func() {
+			sut := newJobPanicsError(panics)
+
+			Expect(sut.Panics()).Should(Equal(panics))
+		})
+	})
+})
@@ -0,0 +1,67 @@
+package jobgroup
+
+import (
+	"context"
+	"time"
+)
+
+// NoJobTimeout is used to signify jobs should not have a timeout.
+const NoJobTimeout = 0
+
+var _ jobGroup = (*jobTimeout)(nil)
+
+type jobTimeout struct {
+	withParent
+
+	timeout time.Duration
+}
+
+// WithJobTimeout returns a new `JobGroup`, child of `parent`, that gives each job its own deadline.
+//
+// Each job is passed a context derived from the group's, that ends once `timeout` elapsed.
+// Only that job is affected when its deadline is reached: the group and other jobs keep going.
+// The timeout starts when the job starts running, so time spent waiting on a concurrency
+// limit of a parent group doesn't count.
+//
+// If a job returns an error after its deadline was reached, the error is wrapped in a `JobTimeoutError`.
+//
+// If `timeout` is `NoJobTimeout`, then this function is equivalent to `WithParent`.
+func WithJobTimeout(parent JobGroup, timeout time.Duration) JobGroup {
+	if timeout == NoJobTimeout {
+		return WithParent(parent)
+	}
+
+	return initGroup(parent.Ctx(), &jobTimeout{
+		withParent: newWithParent(parent),
+
+		timeout: timeout,
+	})
+}
+
+func (g *jobTimeout) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *jobTimeout) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithJobTimeout"
+}
+
+func (g *jobTimeout) launch(job *boundJob) {
+	job.Wrap(func(userJob Job) Job {
+		return func(groupCtx context.Context) error {
+			ctx, cancel := context.WithTimeout(groupCtx, g.timeout)
+			defer cancel()
+
+			err := userJob(ctx)
+
+			// Only blame the job's deadline if the group itself is still going.
+			if err != nil && ctx.Err() == context.DeadlineExceeded && groupCtx.Err() == nil {
+				return newJobTimeoutError(g.timeout, err)
+			}
+
+			return err
+		}
+	})
+
+	g.withParent.launch(job)
+}
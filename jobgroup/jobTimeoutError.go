@@ -0,0 +1,31 @@
+package jobgroup
+
+import (
+	"fmt"
+	"time"
+)
+
+// JobTimeoutError is returned when a job of a `WithJobTimeout` group failed after reaching its deadline.
+type JobTimeoutError struct {
+	timeout time.Duration
+	inner   error
+}
+
+func newJobTimeoutError(timeout time.Duration, inner error) *JobTimeoutError {
+	return &JobTimeoutError{timeout: timeout, inner: inner}
+}
+
+// Error implements `error`.
+func (e *JobTimeoutError) Error() string {
+	return fmt.Sprintf("job timed out after %s: %v", e.timeout, e.inner)
+}
+
+// Unwrap implements the interface expected by `errors.Unwrap`.
+func (e *JobTimeoutError) Unwrap() error {
+	return e.inner
+}
+
+// Timeout returns the timeout the job exceeded.
+func (e *JobTimeoutError) Timeout() time.Duration {
+	return e.timeout
+}
@@ -0,0 +1,40 @@
+package jobgroup
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobTimeoutError", func() {
+	Describe("Error", func() {
+		It("contains the timeout and inner error", func() {
+			inner := errors.New("test inner error string")
+
+			sut := newJobTimeoutError(time.Second, inner)
+
+			Expect(sut.Error()).Should(ContainSubstring(inner.Error()))
+			Expect(sut.Error()).Should(ContainSubstring(time.Second.String()))
+		})
+	})
+
+	Describe("Unwrap", func() {
+		It("returns the inner error", func() {
+			inner := errors.New("test inner error string")
+
+			sut := newJobTimeoutError(time.Second, inner)
+
+			Expect(sut.Unwrap()).Should(BeIdenticalTo(inner))
+		})
+	})
+
+	Describe("Timeout", func() {
+		It("returns the timeout", func() {
+			sut := newJobTimeoutError(time.Second, errors.New("test inner error string"))
+
+			Expect(sut.Timeout()).Should(Equal(time.Second))
+		})
+	})
+})
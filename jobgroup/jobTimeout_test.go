@@ -0,0 +1,170 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("jobTimeout", func() {
+	var (
+		sutParent     *MockjobGroup
+		sutParentCtx  *identifiableContext
+		sutParentImpl jobGroup
+
+		sut        *jobTimeout
+		sutTimeout time.Duration
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+
+		sutParentCtx = newIdentifiableContext(context.Background())
+		DeferCleanup(sutParentCtx.Cancel)
+
+		sutParent = NewMockjobGroup(ctrl)
+
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
+		sutParent.EXPECT().
+			Ctx().
+			Return(sutParentCtx)
+
+		group, _ := WithContext(sutParentCtx)
+		DeferCleanup(group.Close)
+
+		sutParentImpl = downcastGroup(group)
+
+		sutTimeout = 20 * time.Millisecond
+	})
+
+	JustBeforeEach(func() {
+		sut = WithJobTimeout(sutParent, sutTimeout).(*jobTimeout)
+		Expect(sut).ShouldNot(BeNil())
+		Expect(sut.parent).ShouldNot(BeNil())
+		Expect(sut.parent).Should(BeIdenticalTo(sutParent))
+
+		DeferCleanup(sut.Close)
+	})
+
+	Describe("WithJobTimeout", func() {
+		When("the timeout is `NoJobTimeout`", func() {
+			It("should not add a timeout", func() {
+				sutParent.EXPECT().
+					Ctx().
+					Return(sutParentCtx)
+
+				group := WithJobTimeout(sutParent, NoJobTimeout)
+				defer group.Close()
+
+				casted := group.(*withParent)
+				Expect(casted).ShouldNot(BeNil())
+				Expect(casted.parent).Should(BeIdenticalTo(sutParent))
+			})
+		})
+	})
+
+	Describe("Go", func() {
+		It("should call parent.launch", func() {
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			sut.Go(func(ctx context.Context) error {
+				return nil
+			})
+
+			Expect(sut.Wait()).Should(Succeed())
+		})
+
+		It("gives the job a child context with a deadline", func() {
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			sut.Go(func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Expect(sutParentCtx.IsParentOf(ctx)).Should(BeTrue())
+
+				deadline, ok := ctx.Deadline()
+				Expect(ok).Should(BeTrue())
+				Expect(deadline).Should(BeTemporally("~", time.Now().Add(sutTimeout), sutTimeout))
+
+				return nil
+			})
+
+			Expect(sut.Wait()).Should(Succeed())
+		})
+
+		It("returns a JobTimeoutError when the job exceeds its deadline", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			sut.Go(blockUntilCtxDoneErr)
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(MatchError(context.DeadlineExceeded))
+
+			var timeoutErr *JobTimeoutError
+			Expect(errors.As(err, &timeoutErr)).Should(BeTrue())
+			Expect(timeoutErr.Timeout()).Should(Equal(sutTimeout))
+
+			By("not cancelling the group", func() {
+				Expect(sut.Ctx().Err()).Should(Succeed())
+			})
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("doesn't affect other jobs", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Times(2).
+				Do(sutParentImpl.launch)
+
+			sut.Go(blockUntilCtxDoneErr)
+
+			sut.Go(func(ctx context.Context) error {
+				time.Sleep(sutTimeout / 2)
+
+				return nil
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(MatchError(context.DeadlineExceeded))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		When("the group is cancelled", func() {
+			BeforeEach(func() {
+				sutTimeout = time.Hour
+			})
+
+			It("doesn't return a JobTimeoutError", func(testCtx context.Context) {
+				sutParent.EXPECT().
+					launch(gomock.Any()).
+					Do(sutParentImpl.launch)
+
+				sut.Go(blockUntilCtxDoneErr)
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(MatchError(context.Canceled))
+				Expect(errors.As(err, new(*JobTimeoutError))).Should(BeFalse())
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+	})
+})
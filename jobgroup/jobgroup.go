@@ -6,6 +6,9 @@ package jobgroup
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+	"time"
 )
 
 // Job is a function that can run as part of a `JobGroup`.
@@ -36,6 +39,17 @@ type JobGroup interface {
 	// Calling this function more than once has no effect.
 	Close()
 
+	// CloseCtx cancels the group's context, and then waits for jobs like `Close`.
+	// Unlike `Close`, it stops waiting if `ctx` ends before all jobs are done.
+	//
+	// In that case, a `StuckJobsError` listing the jobs that are still running is returned,
+	// and the group is not closed: `Close` or `CloseCtx` should be called again.
+	// Otherwise, nil is returned, and failures are handled like `Close` does.
+	CloseCtx(ctx context.Context) error
+
+	// CloseTimeout is like `CloseCtx`, but it stops waiting after `timeout`.
+	CloseTimeout(timeout time.Duration) error
+
 	// Go starts a job as part of the group. It returns immediately, starting the
 	// job in another goroutine.
 	//
@@ -43,6 +57,26 @@ type JobGroup interface {
 	// concurrency limit. The job's goroutine blocks until it can advance.
 	Go(job Job)
 
+	// GoNamed is like `Go`, but the job has the given name and labels.
+	//
+	// The name and labels are available from the job's context using `JobNameFromCtx` and `JobLabelsFromCtx`.
+	// If the job fails, its error is wrapped in a `JobError`.
+	GoNamed(name string, labels JobLabels, job Job)
+
+	// TryGo is like `Go`, but only starts the job if it can make progress immediately.
+	//
+	// If the job would have to wait, for example due to a concurrency limit, it is not
+	// started and false is returned.
+	TryGo(job Job) bool
+
+	// GoCtx is like `Go`, but if the job cannot make progress immediately, the current
+	// goroutine blocks until it can, instead of the job's goroutine.
+	//
+	// This allows applying backpressure before starting any goroutine.
+	// If `ctx` ends before the job can make progress, the job is not started and the
+	// context's error is returned.
+	GoCtx(ctx context.Context, job Job) error
+
 	// Wait blocks until all jobs of the group, and any child groups, are done.
 	//
 	// It returns all errors of jobs launched on the group directly.
@@ -54,6 +88,17 @@ type JobGroup interface {
 	// The returned bool is true when it waited for all jobs, false when the given context ended.
 	WaitCtx(ctx context.Context) (error, bool)
 
+	// Stats returns the counters of the group's jobs, including those of child groups.
+	//
+	// The counters are always maintained, so this is cheap enough to be called periodically,
+	// for example to export metrics.
+	Stats() GroupStats
+
+	// Inspect returns a snapshot of the group's jobs, and those of its child groups.
+	//
+	// This is meant for debugging: for example to find which jobs prevent a group from closing.
+	Inspect() GroupSnapshot
+
 	// private prevents the interface from being implemented outside this package.
 	private()
 }
@@ -66,8 +111,18 @@ type jobGroup interface {
 
 	launch(*boundJob)
 
+	// admit blocks until the job could make progress immediately, or `ctx` ends.
+	// Once it returns nil, the job must be launched with `admitted` set.
+	admit(context.Context, *boundJob) error
+
 	saveErr(error)
-	savePanic(any)
+	savePanic(*JobPanicError)
+
+	addChild(jobGroup)
+	removeChild(jobGroup)
+
+	// describe adds the details specific to the group's type to `snapshot`.
+	describe(snapshot *GroupSnapshot)
 }
 
 func downcastGroup(group JobGroup) jobGroup {
@@ -100,37 +155,145 @@ type boundJob struct {
 	group jobGroup
 	run   Job
 
+	// userJob is the job before `Wrap`, used to identify it.
+	userJob Job
+
+	// meta is set for jobs started with `GoNamed`.
+	meta *jobMeta
+
 	cleanup func()
+
+	// limits are waited for before `run`, see `Acquire`.
+	limits []func(ctx context.Context) error
+
+	// admitted is true when the job went through `admit`, and shouldn't wait
+	// for any limit when launched.
+	admitted bool
+
+	// result is the outcome of the job, available to cleanup functions.
+	result error
+
+	// panicked is true if the job panicked, available to cleanup functions.
+	panicked bool
+
+	// submitted is when the job was given to the group.
+	submitted time.Time
+
+	// goroutine is the ID of the goroutine running the job, 0 until it starts.
+	// It is used to find the job's stack in reports about stuck jobs.
+	goroutine atomic.Uint64
+
+	// runningSince is when the job started running in Unix nanoseconds, 0 while it waits for limits.
+	runningSince atomic.Int64
+
+	// stats are the counters of each group the job is part of, see `track`.
+	stats []*groupStats
+
+	// observation is set when the group has observers, see `WithObserver`.
+	observation *jobObservation
 }
 
 func bindJob(group jobGroup, userJob Job) *boundJob {
-	return &boundJob{
+	job := &boundJob{
 		group: group,
 		run:   userJob,
 
+		userJob: userJob,
+
+		meta: nil, // see GoNamed
+
 		cleanup: func() {}, // simplifies `Defer`
+
+		limits: nil, // see Acquire
+
+		admitted: false, // see JobGroup.GoCtx
+
+		result:   nil,   // see Main
+		panicked: false, // see recovered
+
+		submitted: time.Now(),
+
+		goroutine: atomic.Uint64{}, // see Main
+
+		runningSince: atomic.Int64{}, // see below
+
+		stats: nil, // see withContext.track
+
+		observation: nil, // see below
+	}
+
+	if observers := observersFromCtx(group.Ctx()); len(observers) != 0 {
+		job.observe(observers)
+	}
+
+	// First wrapper so it's the last to run before the user job, once all limits allow it.
+	job.Wrap(func(userJob Job) Job {
+		return func(ctx context.Context) error {
+			job.started()
+
+			return userJob(ctx)
+		}
+	})
+
+	return job
+}
+
+// started records the job started running.
+func (j *boundJob) started() {
+	now := time.Now()
+
+	if !j.runningSince.CompareAndSwap(0, now.UnixNano()) {
+		return // already started, for example when retried by `WithRetry`
+	}
+
+	waited := now.Sub(j.submitted)
+
+	for _, stats := range j.stats {
+		stats.jobStarted(waited)
+	}
+
+	if j.observation != nil {
+		j.observation.jobStarted(now)
 	}
 }
 
 func (j *boundJob) Main() {
+	j.goroutine.Store(currentGoroutineID())
+
 	defer j.cleanup()
 
 	defer func() {
 		if val := recover(); val != nil {
-			j.group.savePanic(val)
+			j.result = j.recovered(val)
 		}
 	}()
 
 	ctx := j.group.Ctx()
 
+	if j.meta != nil {
+		ctx = context.WithValue(ctx, jobMetaCtxKey, j.meta)
+	}
+
 	var err error
 
 	if ctxErr := ctx.Err(); ctxErr != nil {
 		err = newJobNotStartedError(ctxErr)
+	} else if limitErr := j.acquireLimits(ctx); limitErr != nil {
+		err = newJobNotStartedError(limitErr)
 	} else {
 		err = j.run(ctx)
 	}
 
+	if err != nil && j.meta != nil {
+		err = newJobError(j.meta, err)
+	}
+
+	j.result = err
+
+	if j.observation != nil {
+		j.observation.jobDone(err)
+	}
+
 	if err != nil {
 		// Only save the error on the bound group.
 		// Error will be propagated to its parent, if any, on `Close`.
@@ -138,10 +301,53 @@ func (j *boundJob) Main() {
 	}
 }
 
+// recovered handles the panic of the job according to the group's `PanicPolicy`, and returns it as an error.
+func (j *boundJob) recovered(val any) error {
+	j.panicked = true
+
+	err := j.panicError(val)
+
+	if j.observation != nil {
+		j.observation.jobPanicked(err)
+	}
+
+	switch panicPolicyFromCtx(j.group.Ctx()) {
+	case PropagatePanics:
+		if panics, ok := err.(*JobPanicsError); ok { //nolint:errorlint // not wrapped
+			for _, p := range panics.panics {
+				j.group.savePanic(p)
+			}
+		} else {
+			j.group.savePanic(err.(*JobPanicError)) //nolint:forcetypeassert,errorlint
+		}
+
+	case ConvertPanics:
+		j.group.saveErr(err)
+
+	case CrashOnPanic:
+		crash(err)
+	}
+
+	return err
+}
+
+// panicError returns the panic `val` of the job as an error.
+func (j *boundJob) panicError(val any) error {
+	switch val := val.(type) {
+	case *JobPanicError, *JobPanicsError:
+		// A child group propagated the panic of one of its jobs: keep the original stack.
+		return val.(error) //nolint:forcetypeassert
+
+	default:
+		return newJobPanicError(val, debug.Stack(), j.name(), j.labels())
+	}
+}
+
 // Defer is used to defer cleanup for the job.
 //
 // The given function will be called even if the job doesn't fully lauch
 // due to the context being already done.
+// It can read the job's outcome from `result`.
 func (j *boundJob) Defer(cleanup func()) {
 	prev := j.cleanup
 
@@ -152,6 +358,27 @@ func (j *boundJob) Defer(cleanup func()) {
 	}
 }
 
+// Acquire adds a limit the job waits for before running.
+//
+// Limits are waited for in the order they were added, so those of a group come before those of its
+// parents, and before any limit waited for by `Wrap`, which runs the parent's wrapper first.
+// This is required for limits granted when the job is submitted, such as a `semaphore` ticket:
+// waiting for a parent's limit first would hold it while the ticket blocks the jobs queued behind it.
+func (j *boundJob) Acquire(wait func(ctx context.Context) error) {
+	j.limits = append(j.limits, wait)
+}
+
+func (j *boundJob) acquireLimits(ctx context.Context) error {
+	for _, wait := range j.limits {
+		err := wait(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Wrap adds logic to the job's run function.
 func (j *boundJob) Wrap(wrap func(userJob Job) Job) {
 	j.run = wrap(j.run)
@@ -7,6 +7,7 @@ package jobgroup
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -58,6 +59,34 @@ func (mr *MockJobGroupMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockJobGroup)(nil).Close))
 }
 
+// CloseCtx mocks base method.
+func (m *MockJobGroup) CloseCtx(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseCtx", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseCtx indicates an expected call of CloseCtx.
+func (mr *MockJobGroupMockRecorder) CloseCtx(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseCtx", reflect.TypeOf((*MockJobGroup)(nil).CloseCtx), ctx)
+}
+
+// CloseTimeout mocks base method.
+func (m *MockJobGroup) CloseTimeout(timeout time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseTimeout", timeout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseTimeout indicates an expected call of CloseTimeout.
+func (mr *MockJobGroupMockRecorder) CloseTimeout(timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseTimeout", reflect.TypeOf((*MockJobGroup)(nil).CloseTimeout), timeout)
+}
+
 // Ctx mocks base method.
 func (m *MockJobGroup) Ctx() context.Context {
 	m.ctrl.T.Helper()
@@ -84,6 +113,74 @@ func (mr *MockJobGroupMockRecorder) Go(job interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Go", reflect.TypeOf((*MockJobGroup)(nil).Go), job)
 }
 
+// GoCtx mocks base method.
+func (m *MockJobGroup) GoCtx(ctx context.Context, job Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GoCtx", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GoCtx indicates an expected call of GoCtx.
+func (mr *MockJobGroupMockRecorder) GoCtx(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoCtx", reflect.TypeOf((*MockJobGroup)(nil).GoCtx), ctx, job)
+}
+
+// GoNamed mocks base method.
+func (m *MockJobGroup) GoNamed(name string, labels JobLabels, job Job) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GoNamed", name, labels, job)
+}
+
+// GoNamed indicates an expected call of GoNamed.
+func (mr *MockJobGroupMockRecorder) GoNamed(name, labels, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoNamed", reflect.TypeOf((*MockJobGroup)(nil).GoNamed), name, labels, job)
+}
+
+// Inspect mocks base method.
+func (m *MockJobGroup) Inspect() GroupSnapshot {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Inspect")
+	ret0, _ := ret[0].(GroupSnapshot)
+	return ret0
+}
+
+// Inspect indicates an expected call of Inspect.
+func (mr *MockJobGroupMockRecorder) Inspect() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Inspect", reflect.TypeOf((*MockJobGroup)(nil).Inspect))
+}
+
+// Stats mocks base method.
+func (m *MockJobGroup) Stats() GroupStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(GroupStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockJobGroupMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockJobGroup)(nil).Stats))
+}
+
+// TryGo mocks base method.
+func (m *MockJobGroup) TryGo(job Job) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryGo", job)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// TryGo indicates an expected call of TryGo.
+func (mr *MockJobGroupMockRecorder) TryGo(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryGo", reflect.TypeOf((*MockJobGroup)(nil).TryGo), job)
+}
+
 // Wait mocks base method.
 func (m *MockJobGroup) Wait() error {
 	m.ctrl.T.Helper()
@@ -172,6 +269,34 @@ func (mr *MockjobGroupMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockjobGroup)(nil).Close))
 }
 
+// CloseCtx mocks base method.
+func (m *MockjobGroup) CloseCtx(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseCtx", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseCtx indicates an expected call of CloseCtx.
+func (mr *MockjobGroupMockRecorder) CloseCtx(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseCtx", reflect.TypeOf((*MockjobGroup)(nil).CloseCtx), ctx)
+}
+
+// CloseTimeout mocks base method.
+func (m *MockjobGroup) CloseTimeout(timeout time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseTimeout", timeout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseTimeout indicates an expected call of CloseTimeout.
+func (mr *MockjobGroupMockRecorder) CloseTimeout(timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseTimeout", reflect.TypeOf((*MockjobGroup)(nil).CloseTimeout), timeout)
+}
+
 // Ctx mocks base method.
 func (m *MockjobGroup) Ctx() context.Context {
 	m.ctrl.T.Helper()
@@ -198,6 +323,74 @@ func (mr *MockjobGroupMockRecorder) Go(job interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Go", reflect.TypeOf((*MockjobGroup)(nil).Go), job)
 }
 
+// GoCtx mocks base method.
+func (m *MockjobGroup) GoCtx(ctx context.Context, job Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GoCtx", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GoCtx indicates an expected call of GoCtx.
+func (mr *MockjobGroupMockRecorder) GoCtx(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoCtx", reflect.TypeOf((*MockjobGroup)(nil).GoCtx), ctx, job)
+}
+
+// GoNamed mocks base method.
+func (m *MockjobGroup) GoNamed(name string, labels JobLabels, job Job) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GoNamed", name, labels, job)
+}
+
+// GoNamed indicates an expected call of GoNamed.
+func (mr *MockjobGroupMockRecorder) GoNamed(name, labels, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoNamed", reflect.TypeOf((*MockjobGroup)(nil).GoNamed), name, labels, job)
+}
+
+// Inspect mocks base method.
+func (m *MockjobGroup) Inspect() GroupSnapshot {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Inspect")
+	ret0, _ := ret[0].(GroupSnapshot)
+	return ret0
+}
+
+// Inspect indicates an expected call of Inspect.
+func (mr *MockjobGroupMockRecorder) Inspect() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Inspect", reflect.TypeOf((*MockjobGroup)(nil).Inspect))
+}
+
+// Stats mocks base method.
+func (m *MockjobGroup) Stats() GroupStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(GroupStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockjobGroupMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockjobGroup)(nil).Stats))
+}
+
+// TryGo mocks base method.
+func (m *MockjobGroup) TryGo(job Job) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryGo", job)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// TryGo indicates an expected call of TryGo.
+func (mr *MockjobGroupMockRecorder) TryGo(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryGo", reflect.TypeOf((*MockjobGroup)(nil).TryGo), job)
+}
+
 // Wait mocks base method.
 func (m *MockjobGroup) Wait() error {
 	m.ctrl.T.Helper()
@@ -227,6 +420,44 @@ func (mr *MockjobGroupMockRecorder) WaitCtx(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitCtx", reflect.TypeOf((*MockjobGroup)(nil).WaitCtx), ctx)
 }
 
+// addChild mocks base method.
+func (m *MockjobGroup) addChild(arg0 jobGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "addChild", arg0)
+}
+
+// addChild indicates an expected call of addChild.
+func (mr *MockjobGroupMockRecorder) addChild(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "addChild", reflect.TypeOf((*MockjobGroup)(nil).addChild), arg0)
+}
+
+// admit mocks base method.
+func (m *MockjobGroup) admit(arg0 context.Context, arg1 *boundJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "admit", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// admit indicates an expected call of admit.
+func (mr *MockjobGroupMockRecorder) admit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "admit", reflect.TypeOf((*MockjobGroup)(nil).admit), arg0, arg1)
+}
+
+// describe mocks base method.
+func (m *MockjobGroup) describe(snapshot *GroupSnapshot) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "describe", snapshot)
+}
+
+// describe indicates an expected call of describe.
+func (mr *MockjobGroupMockRecorder) describe(snapshot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "describe", reflect.TypeOf((*MockjobGroup)(nil).describe), snapshot)
+}
+
 // init mocks base method.
 func (m *MockjobGroup) init(arg0 context.Context, arg1 JobGroup) {
 	m.ctrl.T.Helper()
@@ -263,6 +494,18 @@ func (mr *MockjobGroupMockRecorder) private() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "private", reflect.TypeOf((*MockjobGroup)(nil).private))
 }
 
+// removeChild mocks base method.
+func (m *MockjobGroup) removeChild(arg0 jobGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "removeChild", arg0)
+}
+
+// removeChild indicates an expected call of removeChild.
+func (mr *MockjobGroupMockRecorder) removeChild(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "removeChild", reflect.TypeOf((*MockjobGroup)(nil).removeChild), arg0)
+}
+
 // saveErr mocks base method.
 func (m *MockjobGroup) saveErr(arg0 error) {
 	m.ctrl.T.Helper()
@@ -276,7 +519,7 @@ func (mr *MockjobGroupMockRecorder) saveErr(arg0 interface{}) *gomock.Call {
 }
 
 // savePanic mocks base method.
-func (m *MockjobGroup) savePanic(arg0 any) {
+func (m *MockjobGroup) savePanic(arg0 *JobPanicError) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "savePanic", arg0)
 }
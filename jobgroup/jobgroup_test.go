@@ -3,7 +3,9 @@ package jobgroup
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -81,6 +83,56 @@ func blockUntilCtxDone(ctx context.Context) error {
 	}
 }
 
+// blockUntilCtxDoneErr is like `blockUntilCtxDone`, but returns the context's error.
+func blockUntilCtxDoneErr(ctx context.Context) error {
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+// expectNoDeadlockUnderSaturatedParent checks jobs of the group created by `newGroup` start once a
+// `WithMaxConcurrency` parent, saturated when they are submitted, has capacity again.
+func expectNoDeadlockUnderSaturatedParent(testCtx context.Context, newGroup func(parent JobGroup) JobGroup) {
+	root, _ := WithContext(context.Background())
+	DeferCleanup(root.Close)
+
+	parent := WithMaxConcurrency(root, 1)
+	sut := newGroup(parent)
+
+	blockCtx, unblock := context.WithCancel(testCtx)
+	started := make(chan struct{})
+
+	parent.Go(func(context.Context) error {
+		close(started)
+
+		return blockUntilCtxDone(blockCtx)
+	})
+
+	Eventually(testCtx, started).Should(BeClosed())
+
+	var runs atomic.Int32
+
+	for i := 0; i < 4; i++ {
+		sut.Go(func(context.Context) error {
+			runs.Add(1)
+
+			return nil
+		})
+	}
+
+	// Also lets all jobs wait for the parent
+	Consistently(runs.Load, 10*time.Millisecond).Should(BeZero())
+
+	unblock()
+
+	err, ok := sut.WaitCtx(testCtx)
+	Expect(ok).Should(BeTrue()) // false is a deadlock
+	Expect(err).Should(Succeed())
+	Expect(runs.Load()).Should(BeNumerically("==", 4))
+
+	Expect(parent.CloseCtx(testCtx)).Should(Succeed())
+}
+
 // identifiableContext is a cancellable context that can verify if another context was derived from it.
 type identifiableContext struct {
 	context.Context //nolint:containedctx
@@ -0,0 +1,176 @@
+package jobgroup
+
+import (
+	"context"
+
+	"github.com/ThinkChaos/parcour/zync"
+)
+
+var _ jobGroup = (*keyedConcurrency[int])(nil)
+
+// KeyedJobGroup is a `JobGroup` whose jobs can have a key.
+type KeyedJobGroup[K comparable] interface {
+	JobGroup
+
+	// GoWithKey is like `Go`, but the job counts towards the limit of `key`.
+	//
+	// Jobs with the same key start in the order they were submitted.
+	// Jobs started with other methods, or from child groups, have no key.
+	GoWithKey(key K, job Job)
+}
+
+type keyedConcurrency[K comparable] struct {
+	withParent
+
+	sem *semaphore // overall limit
+
+	perKey uint
+	keys   zync.Mutex[map[K]*keySemaphore]
+}
+
+// keySemaphore is the semaphore of a key, and the number of jobs using it.
+type keySemaphore struct {
+	sem  *semaphore
+	refs uint
+}
+
+// WithKeyedConcurrency returns a new `KeyedJobGroup`, child of `parent`, that limits the number of
+// concurrent jobs with the same key to `perKey`, and the number of concurrent jobs overall to `max`.
+//
+// A job waits for a slot of its key before waiting for an overall slot, so jobs of a busy key
+// don't prevent jobs of other keys from starting.
+//
+// The per-key limiters are created when needed, and discarded once no job uses them.
+//
+// If `perKey` or `max` is `NoConcurrencyLimit`, the corresponding limit is disabled.
+func WithKeyedConcurrency[K comparable](parent JobGroup, perKey, max uint) KeyedJobGroup[K] {
+	group := &keyedConcurrency[K]{
+		withParent: newWithParent(parent),
+
+		sem: newSemaphore(limitToSemaphore(max)),
+
+		perKey: limitToSemaphore(perKey),
+		keys:   zync.NewMutex(make(map[K]*keySemaphore)),
+	}
+
+	initGroup(parent.Ctx(), group)
+
+	return group
+}
+
+// WithKeyedSerialization returns a new `KeyedJobGroup`, child of `parent`, where jobs with the same key
+// run one at a time, in the order they were submitted.
+// Jobs with different keys run concurrently.
+//
+// A job failing doesn't prevent the next jobs with the same key from running.
+func WithKeyedSerialization[K comparable](parent JobGroup) KeyedJobGroup[K] {
+	return WithKeyedConcurrency[K](parent, 1, NoConcurrencyLimit)
+}
+
+func (g *keyedConcurrency[K]) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *keyedConcurrency[K]) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithKeyedConcurrency"
+	snapshot.Concurrency = g.sem.usage()
+}
+
+func (g *keyedConcurrency[K]) GoWithKey(key K, job Job) {
+	bound := bindJob(g, job)
+
+	// Queue from the current goroutine to keep submission order.
+	keyTicket := g.enqueueKey(key)
+
+	var ticket *semaphoreTicket // see below
+
+	bound.Defer(func() {
+		if ticket != nil {
+			ticket.leave()
+		}
+
+		keyTicket.leave()
+		g.releaseKey(key)
+	})
+
+	bound.Acquire(func(ctx context.Context) error {
+		err := keyTicket.wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Only queue for the overall limit once the key allows it, so jobs with other keys can start.
+		ticket, err = g.sem.acquire(ctx, DefaultPriority, DefaultCost)
+
+		return err
+	})
+
+	g.withParent.launch(bound)
+}
+
+func (g *keyedConcurrency[K]) admit(ctx context.Context, job *boundJob) error {
+	ticket, err := g.sem.acquire(ctx, DefaultPriority, DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	err = g.withParent.admit(ctx, job)
+	if err != nil {
+		ticket.leave()
+
+		return err
+	}
+
+	job.Defer(ticket.leave)
+
+	return nil
+}
+
+func (g *keyedConcurrency[K]) launch(job *boundJob) {
+	if !job.admitted { // otherwise the slot was taken by `admit`
+		ticket := g.sem.enqueue(DefaultPriority, DefaultCost)
+		job.Defer(ticket.leave)
+
+		job.Acquire(ticket.wait)
+	}
+
+	g.withParent.launch(job)
+}
+
+// enqueueKey takes a place in the queue of `key`, creating its semaphore if needed.
+//
+// `releaseKey` must be called once the ticket was left.
+func (g *keyedConcurrency[K]) enqueueKey(key K) *semaphoreTicket {
+	var ticket *semaphoreTicket
+
+	g.keys.WithLock(func(keys *map[K]*keySemaphore) {
+		entry, ok := (*keys)[key]
+		if !ok {
+			entry = &keySemaphore{
+				sem:  newSemaphore(g.perKey),
+				refs: 0,
+			}
+
+			(*keys)[key] = entry
+		}
+
+		entry.refs++
+
+		// Still holding the lock so the semaphore can't be discarded in between.
+		ticket = entry.sem.enqueue(DefaultPriority, DefaultCost)
+	})
+
+	return ticket
+}
+
+// releaseKey discards the semaphore of `key` if no job uses it anymore.
+func (g *keyedConcurrency[K]) releaseKey(key K) {
+	g.keys.WithLock(func(keys *map[K]*keySemaphore) {
+		entry := (*keys)[key]
+
+		entry.refs--
+		if entry.refs == 0 {
+			delete(*keys, key)
+		}
+	})
+}
@@ -0,0 +1,322 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("keyedConcurrency", func() {
+	var (
+		sutParent     *MockjobGroup
+		sutParentCtx  *identifiableContext
+		sutParentImpl jobGroup
+
+		sut       *keyedConcurrency[string]
+		sutPerKey uint
+		sutMax    uint
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+
+		sutParentCtx = newIdentifiableContext(context.Background())
+		DeferCleanup(sutParentCtx.Cancel)
+
+		sutParent = NewMockjobGroup(ctrl)
+
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
+		sutParent.EXPECT().
+			Ctx().
+			Return(sutParentCtx)
+
+		group, _ := WithContext(sutParentCtx)
+		DeferCleanup(group.Close)
+
+		sutParentImpl = downcastGroup(group)
+
+		sutPerKey = 1
+		sutMax = NoConcurrencyLimit
+	})
+
+	JustBeforeEach(func() {
+		sut = WithKeyedConcurrency[string](sutParent, sutPerKey, sutMax).(*keyedConcurrency[string])
+		Expect(sut).ShouldNot(BeNil())
+		Expect(sut.parent).ShouldNot(BeNil())
+		Expect(sut.parent).Should(BeIdenticalTo(sutParent))
+
+		DeferCleanup(sut.Close)
+
+		sutParent.EXPECT().
+			launch(gomock.Any()).
+			AnyTimes().
+			Do(sutParentImpl.launch)
+	})
+
+	expectNoKeys := func() {
+		sut.keys.WithLock(func(keys *map[string]*keySemaphore) {
+			Expect(*keys).Should(BeEmpty())
+		})
+	}
+
+	Describe("WithKeyedSerialization", func() {
+		It("runs jobs with the same key one at a time", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				Ctx().
+				Return(sutParentCtx)
+
+			group := WithKeyedSerialization[string](sutParent)
+			defer group.Close()
+
+			casted := group.(*keyedConcurrency[string])
+			Expect(casted.parent).Should(BeIdenticalTo(sutParent))
+			Expect(casted.perKey).Should(BeNumerically("==", 1))
+
+			const nJobs = 20
+
+			running := make(map[string]*int32)
+			order := make(map[string]chan int)
+
+			for _, key := range []string{"a", "b"} {
+				running[key] = new(int32)
+				order[key] = make(chan int, nJobs)
+			}
+
+			for i := 0; i < nJobs; i++ {
+				i := i
+
+				for _, key := range []string{"a", "b"} {
+					key := key
+
+					group.GoWithKey(key, func(ctx context.Context) error {
+						defer GinkgoRecover()
+
+						Expect(atomic.AddInt32(running[key], 1)).Should(BeNumerically("==", 1))
+						defer atomic.AddInt32(running[key], -1)
+
+						order[key] <- i
+
+						return nil
+					})
+				}
+			}
+
+			err, ok := group.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			for _, key := range []string{"a", "b"} {
+				close(order[key])
+
+				expected := 0
+				for i := range order[key] {
+					Expect(i).Should(Equal(expected))
+					expected++
+				}
+
+				Expect(expected).Should(Equal(nJobs))
+			}
+		}, SpecTimeout(time.Second*timeoutFactor))
+	})
+
+	Describe("GoWithKey", func() {
+		It("limits concurrency per key", func(testCtx context.Context) {
+			events := make(chan string)
+
+			sut.GoWithKey("a", func(ctx context.Context) error {
+				events <- "a1 start"
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, events).Should(Receive(Equal("a1 start")))
+
+			sut.GoWithKey("a", func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Fail("a2 should never run")
+
+				return nil
+			})
+
+			sut.GoWithKey("b", func(ctx context.Context) error {
+				events <- "b1 start"
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, events).Should(Receive(Equal("b1 start")))
+			Consistently(events, 20*time.Millisecond).ShouldNot(Receive())
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(errors.As(err, new(*JobNotStartedError))).Should(BeTrue())
+
+			By("discarding unused keys", expectNoKeys)
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("starts jobs with the same key in submission order", func(testCtx context.Context) {
+			const nJobs = 100
+
+			order := make(chan int, nJobs)
+			expected := make([]int, 0, nJobs)
+
+			for i := 0; i < nJobs; i++ {
+				i := i
+
+				expected = append(expected, i)
+
+				sut.GoWithKey("a", func(ctx context.Context) error {
+					order <- i
+
+					return nil
+				})
+			}
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			close(order)
+
+			actual := make([]int, 0, nJobs)
+			for i := range order {
+				actual = append(actual, i)
+			}
+
+			Expect(actual).Should(Equal(expected))
+
+			expectNoKeys()
+		}, SpecTimeout(time.Second*timeoutFactor))
+
+		When("there is an overall limit", func() {
+			BeforeEach(func() {
+				sutPerKey = NoConcurrencyLimit
+				sutMax = 1
+			})
+
+			It("limits concurrency across keys", func(testCtx context.Context) {
+				started := make(chan struct{})
+
+				sut.GoWithKey("a", func(ctx context.Context) error {
+					close(started)
+
+					return blockUntilCtxDone(ctx)
+				})
+
+				Eventually(testCtx, started).Should(BeClosed())
+
+				sut.GoWithKey("b", func(ctx context.Context) error {
+					defer GinkgoRecover()
+
+					Fail("b1 should never run")
+
+					return nil
+				})
+
+				sut.Go(func(ctx context.Context) error {
+					defer GinkgoRecover()
+
+					Fail("unkeyed job should never run")
+
+					return nil
+				})
+
+				Eventually(testCtx, func() int {
+					var waiting int
+
+					sut.sem.state.WithLock(func(state *semaphoreState) {
+						waiting = len(state.waiters)
+					})
+
+					return waiting
+				}).Should(Equal(2))
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(errors.As(err, new(*JobNotStartedError))).Should(BeTrue())
+
+				By("leaving the queues", func() {
+					sut.sem.state.WithLock(func(state *semaphoreState) {
+						Expect(state.used).Should(BeZero())
+						Expect(state.waiters).Should(BeEmpty())
+					})
+
+					expectNoKeys()
+				})
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+	})
+
+	Describe("TryGo", func() {
+		BeforeEach(func() {
+			sutMax = 1
+		})
+
+		It("refuses the job when the overall limit is reached", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				admit(gomock.Any(), gomock.Any()).
+				DoAndReturn(sutParentImpl.admit)
+
+			started := make(chan struct{})
+
+			Expect(sut.TryGo(func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(ctx)
+			})).Should(BeTrue())
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			Expect(sut.TryGo(func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Fail("job should never run")
+
+				return nil
+			})).Should(BeFalse())
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+	DescribeTable("doesn't deadlock under a saturated parent",
+		func(testCtx context.Context, newGroup func(parent JobGroup) JobGroup) {
+			expectNoDeadlockUnderSaturatedParent(testCtx, newGroup)
+		},
+		Entry("WithKeyedConcurrency", func(parent JobGroup) JobGroup {
+			return WithKeyedConcurrency[string](parent, 1, 1)
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor)),
+		Entry("WithKeyedSerialization", func(parent JobGroup) JobGroup {
+			return sameKeyGroup{WithKeyedSerialization[string](parent)}
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor)),
+	)
+})
+
+// sameKeyGroup starts all jobs with the same key.
+type sameKeyGroup struct {
+	KeyedJobGroup[string]
+}
+
+func (g sameKeyGroup) Go(job Job) {
+	g.GoWithKey("key", job)
+}
@@ -21,6 +21,10 @@ type maxConcurrency struct {
 // Note that the created group will still be subject to any concurrency limits of the parent group.
 //
 // If multiple jobs are blocked waiting to start and another finishes, the one to actually start is chosen randomly.
+// Use `WithFairMaxConcurrency` if jobs should start in the order they were submitted.
+//
+// Jobs started with `Go` wait for the limit in their own goroutine. Use `TryGo` or `GoCtx` to
+// wait before the goroutine is started.
 func WithMaxConcurrency(parent JobGroup, max uint) JobGroup {
 	if max == NoConcurrencyLimit {
 		return WithParent(parent)
@@ -37,9 +41,45 @@ func (g *maxConcurrency) Go(job Job) {
 	g.launch(bindJob(g, job))
 }
 
+func (g *maxConcurrency) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithMaxConcurrency"
+	snapshot.Concurrency = &ConcurrencySnapshot{
+		Limit: uint(cap(g.ch)),
+		InUse: uint(len(g.ch)),
+	}
+}
+
+func (g *maxConcurrency) admit(ctx context.Context, job *boundJob) error {
+	// Prefer taking a slot over noticing `ctx` is done: `TryGo` uses an already done context.
+	select {
+	case g.ch <- struct{}{}:
+	default:
+		select {
+		case g.ch <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	err := g.withParent.admit(ctx, job)
+	if err != nil {
+		<-g.ch
+
+		return err
+	}
+
+	job.Defer(func() { <-g.ch })
+
+	return nil
+}
+
 func (g *maxConcurrency) launch(job *boundJob) {
 	job.Wrap(func(userJob Job) Job {
 		return func(ctx context.Context) error {
+			if job.admitted {
+				return userJob(ctx) // slot was taken by `admit`
+			}
+
 			select {
 			case g.ch <- struct{}{}:
 				defer func() { <-g.ch }()
@@ -29,6 +29,14 @@ var _ = Describe("maxConcurrency", func() {
 
 		sutParent = NewMockjobGroup(ctrl)
 
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
 		sutParent.EXPECT().
 			Ctx().
 			Return(sutParentCtx)
@@ -275,4 +283,159 @@ var _ = Describe("maxConcurrency", func() {
 			})
 		})
 	})
+
+	Describe("TryGo", func() {
+		BeforeEach(func() {
+			sutLimit = 1
+		})
+
+		It("starts the job when the limit is not reached", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				admit(gomock.Any(), gomock.Any()).
+				DoAndReturn(sutParentImpl.admit)
+
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			Expect(sut.TryGo(func(ctx context.Context) error { return nil })).Should(BeTrue())
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			By("releasing the slot once the job ends", func() {
+				Expect(sut.ch).Should(BeEmpty())
+			})
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("refuses the job when the limit is reached", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			started := make(chan struct{})
+
+			sut.Go(func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			Expect(sut.TryGo(func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Fail("job should never run")
+
+				return nil
+			})).Should(BeFalse())
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("releases the slot if the parent refuses the job", func() {
+			sutParent.EXPECT().
+				admit(gomock.Any(), gomock.Any()).
+				Return(context.Canceled)
+
+			Expect(sut.TryGo(func(ctx context.Context) error { return nil })).Should(BeFalse())
+			Expect(sut.ch).Should(BeEmpty())
+		})
+	})
+
+	Describe("GoCtx", func() {
+		BeforeEach(func() {
+			sutLimit = 1
+		})
+
+		It("blocks until the job can start", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Times(2).
+				Do(sutParentImpl.launch)
+
+			sutParent.EXPECT().
+				admit(gomock.Any(), gomock.Any()).
+				DoAndReturn(sutParentImpl.admit)
+
+			events := make(chan string)
+			job1Ctx, job1End := context.WithCancel(testCtx)
+
+			sut.Go(func(ctx context.Context) error {
+				events <- "job 1 start"
+				err := blockUntilCtxDone(job1Ctx)
+				events <- "job 1 end"
+
+				return err
+			})
+
+			Eventually(testCtx, events).Should(Receive(Equal("job 1 start")))
+
+			go func() {
+				defer GinkgoRecover()
+
+				events <- "GoCtx start"
+				err := sut.GoCtx(testCtx, func(ctx context.Context) error {
+					events <- "job 2 start"
+
+					return nil
+				})
+				Expect(err).Should(Succeed())
+				events <- "GoCtx end"
+			}()
+
+			Eventually(testCtx, events).Should(Receive(Equal("GoCtx start")))
+			Consistently(events, 20*time.Millisecond).ShouldNot(Receive())
+
+			job1End()
+
+			Eventually(testCtx, events).Should(Receive(Equal("job 1 end")))
+			Eventually(testCtx, events).Should(Receive(SatisfyAny(Equal("GoCtx end"), Equal("job 2 start"))))
+			Eventually(testCtx, events).Should(Receive(SatisfyAny(Equal("GoCtx end"), Equal("job 2 start"))))
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("returns the context's error if it ends before the job can start", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			started := make(chan struct{})
+
+			sut.Go(func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			ctx, cancel := context.WithTimeout(testCtx, 10*time.Millisecond)
+			defer cancel()
+
+			err := sut.GoCtx(ctx, func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Fail("job should never run")
+
+				return nil
+			})
+			Expect(err).Should(MatchError(context.DeadlineExceeded))
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
 })
@@ -0,0 +1,43 @@
+package jobgroup
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxRestartsError is returned when a `WithSupervisor` group gave up because jobs restarted too often.
+type MaxRestartsError struct {
+	maxRestarts uint
+	window      time.Duration
+	inner       error
+}
+
+func newMaxRestartsError(maxRestarts uint, window time.Duration, inner error) *MaxRestartsError {
+	return &MaxRestartsError{maxRestarts: maxRestarts, window: window, inner: inner}
+}
+
+// Error implements `error`.
+func (e *MaxRestartsError) Error() string {
+	if e.window == 0 {
+		return fmt.Sprintf("supervisor exceeded %d restart(s): %v", e.maxRestarts, e.inner)
+	}
+
+	return fmt.Sprintf("supervisor exceeded %d restart(s) in %s: %v", e.maxRestarts, e.window, e.inner)
+}
+
+// Unwrap implements the interface expected by `errors.Unwrap`.
+//
+// The inner error is that of the job whose restart exceeded the limit. It is nil if the job succeeded.
+func (e *MaxRestartsError) Unwrap() error {
+	return e.inner
+}
+
+// MaxRestarts returns the limit that was exceeded.
+func (e *MaxRestartsError) MaxRestarts() uint {
+	return e.maxRestarts
+}
+
+// Window returns the duration over which restarts were counted.
+func (e *MaxRestartsError) Window() time.Duration {
+	return e.window
+}
@@ -0,0 +1,55 @@
+package jobgroup
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MaxRestartsError", func() {
+	Describe("Error", func() {
+		It("contains the limit, window and inner error", func() {
+			inner := errors.New("test inner error string")
+
+			sut := newMaxRestartsError(3, time.Second, inner)
+
+			Expect(sut.Error()).Should(ContainSubstring(inner.Error()))
+			Expect(sut.Error()).Should(ContainSubstring("3"))
+			Expect(sut.Error()).Should(ContainSubstring(time.Second.String()))
+		})
+
+		It("omits the window when there is none", func() {
+			sut := newMaxRestartsError(3, 0, errors.New("test inner error string"))
+
+			Expect(sut.Error()).ShouldNot(ContainSubstring(" in "))
+		})
+	})
+
+	Describe("Unwrap", func() {
+		It("returns the inner error", func() {
+			inner := errors.New("test inner error string")
+
+			sut := newMaxRestartsError(3, time.Second, inner)
+
+			Expect(sut.Unwrap()).Should(BeIdenticalTo(inner))
+		})
+	})
+
+	Describe("MaxRestarts", func() {
+		It("returns the limit", func() {
+			sut := newMaxRestartsError(3, time.Second, nil)
+
+			Expect(sut.MaxRestarts()).Should(BeNumerically("==", 3))
+		})
+	})
+
+	Describe("Window", func() {
+		It("returns the window", func() {
+			sut := newMaxRestartsError(3, time.Second, nil)
+
+			Expect(sut.Window()).Should(Equal(time.Second))
+		})
+	})
+})
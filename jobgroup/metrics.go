@@ -0,0 +1,226 @@
+package jobgroup
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ThinkChaos/parcour/zync"
+)
+
+// Metrics exports the `Stats` of registered groups in the Prometheus text exposition format.
+//
+// It doesn't depend on the Prometheus client library: it can be served directly, for example:
+//
+//	metrics := jobgroup.NewMetrics()
+//	metrics.Register("workers", group)
+//
+//	http.Handle("/metrics", metrics)
+//
+// Each metric has a `group` label with the name the group was registered with.
+type Metrics struct {
+	groups zync.Mutex[map[string]JobGroup]
+}
+
+// NewMetrics returns a new `Metrics` without any registered group.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		groups: zync.NewMutex(make(map[string]JobGroup)),
+	}
+}
+
+// Register exports the stats of `group` with the given name.
+//
+// It panics if `name` is already registered.
+func (m *Metrics) Register(name string, group JobGroup) {
+	m.groups.WithLock(func(groups *map[string]JobGroup) {
+		if _, ok := (*groups)[name]; ok {
+			panic(fmt.Sprintf("group %q is already registered", name))
+		}
+
+		(*groups)[name] = group
+	})
+}
+
+// Unregister stops exporting the stats of the group registered with the given name.
+//
+// It has no effect if `name` is not registered.
+func (m *Metrics) Unregister(name string) {
+	m.groups.WithLock(func(groups *map[string]JobGroup) {
+		delete(*groups, name)
+	})
+}
+
+// ServeHTTP implements `http.Handler`.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	_, _ = m.WriteTo(w) // nothing to do if the client is gone
+}
+
+// WriteTo implements `io.WriterTo`: it writes the stats of the registered groups, sorted by name.
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	stats := m.collect()
+
+	cw := &countingWriter{w: w, n: 0, err: nil}
+
+	for _, metric := range metricDefs {
+		fmt.Fprintf(cw, "# HELP %s %s\n", metric.name, metric.help)
+		fmt.Fprintf(cw, "# TYPE %s %s\n", metric.name, metric.kind)
+
+		for _, group := range stats {
+			metric.write(cw, metric.name, group.name, &group.stats)
+		}
+	}
+
+	return cw.n, cw.err
+}
+
+type namedStats struct {
+	name  string
+	stats GroupStats
+}
+
+// collect returns the stats of the registered groups, sorted by name.
+func (m *Metrics) collect() []namedStats {
+	var groups map[string]JobGroup
+
+	m.groups.WithLock(func(registered *map[string]JobGroup) {
+		groups = make(map[string]JobGroup, len(*registered))
+
+		for name, group := range *registered {
+			groups[name] = group
+		}
+	})
+
+	res := make([]namedStats, 0, len(groups))
+
+	for name, group := range groups {
+		res = append(res, namedStats{name: name, stats: group.Stats()})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].name < res[j].name
+	})
+
+	return res
+}
+
+// metricDef describes how a metric is written from `GroupStats`.
+type metricDef struct {
+	name string
+	help string
+	kind string
+
+	write func(w io.Writer, name, group string, stats *GroupStats)
+}
+
+//nolint:gochecknoglobals
+var metricDefs = []metricDef{
+	{
+		name: "jobgroup_jobs_started_total",
+		help: "Number of jobs that started running.",
+		kind: "counter",
+		write: func(w io.Writer, name, group string, stats *GroupStats) {
+			writeSample(w, name, group, "", float64(stats.Started))
+		},
+	},
+	{
+		name: "jobgroup_jobs_finished_total",
+		help: "Number of jobs that are done, by outcome.",
+		kind: "counter",
+		write: func(w io.Writer, name, group string, stats *GroupStats) {
+			writeSample(w, name, group, `outcome="completed"`, float64(stats.Completed))
+			writeSample(w, name, group, `outcome="failed"`, float64(stats.Failed))
+			writeSample(w, name, group, `outcome="panicked"`, float64(stats.Panicked))
+			writeSample(w, name, group, `outcome="not_started"`, float64(stats.NotStarted))
+		},
+	},
+	{
+		name: "jobgroup_jobs_running",
+		help: "Number of jobs currently running.",
+		kind: "gauge",
+		write: func(w io.Writer, name, group string, stats *GroupStats) {
+			writeSample(w, name, group, "", float64(stats.Running))
+		},
+	},
+	{
+		name: "jobgroup_jobs_waiting",
+		help: "Number of jobs currently waiting to start.",
+		kind: "gauge",
+		write: func(w io.Writer, name, group string, stats *GroupStats) {
+			writeSample(w, name, group, "", float64(stats.Waiting))
+		},
+	},
+	{
+		name: "jobgroup_job_wait_seconds",
+		help: "Time jobs waited before starting.",
+		kind: "histogram",
+		write: func(w io.Writer, name, group string, stats *GroupStats) {
+			writeHistogram(w, name, group, &stats.WaitTimes)
+		},
+	},
+	{
+		name: "jobgroup_job_run_seconds",
+		help: "Time jobs ran.",
+		kind: "histogram",
+		write: func(w io.Writer, name, group string, stats *GroupStats) {
+			writeHistogram(w, name, group, &stats.RunTimes)
+		},
+	},
+}
+
+func writeHistogram(w io.Writer, name, group string, histogram *DurationHistogram) {
+	for i, bucket := range histogram.Buckets {
+		le := fmt.Sprintf("le=%q", formatFloat(bucket.Seconds()))
+
+		writeSample(w, name+"_bucket", group, le, float64(histogram.Counts[i]))
+	}
+
+	writeSample(w, name+"_bucket", group, `le="+Inf"`, float64(histogram.Count))
+	writeSample(w, name+"_sum", group, "", histogram.Sum.Seconds())
+	writeSample(w, name+"_count", group, "", float64(histogram.Count))
+}
+
+// writeSample writes a line of the exposition format. `labels` are added after the group label.
+func writeSample(w io.Writer, name, group, labels string, value float64) {
+	if labels != "" {
+		labels = "," + labels
+	}
+
+	fmt.Fprintf(w, "%s{group=\"%s\"%s} %s\n", name, escapeLabelValue(group), labels, formatFloat(value))
+}
+
+//nolint:gochecknoglobals
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// countingWriter counts the bytes written, and keeps the first error so writes can be chained.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	n, err := w.w.Write(p)
+
+	w.n += int64(n)
+	w.err = err
+
+	return n, err
+}
@@ -0,0 +1,132 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metrics", func() {
+	var (
+		group JobGroup
+		sut   *Metrics
+	)
+
+	BeforeEach(func() {
+		group, _ = WithContext(context.Background())
+		DeferCleanup(group.Close)
+
+		sut = NewMetrics()
+	})
+
+	export := func() string {
+		var b strings.Builder
+
+		n, err := sut.WriteTo(&b)
+		Expect(err).Should(Succeed())
+		Expect(n).Should(BeNumerically("==", b.Len()))
+
+		return b.String()
+	}
+
+	It("only has metadata without groups", func() {
+		out := export()
+
+		Expect(out).Should(ContainSubstring("# HELP jobgroup_jobs_started_total "))
+		Expect(out).Should(ContainSubstring("# TYPE jobgroup_jobs_started_total counter\n"))
+		Expect(out).Should(ContainSubstring("# TYPE jobgroup_jobs_running gauge\n"))
+		Expect(out).Should(ContainSubstring("# TYPE jobgroup_job_run_seconds histogram\n"))
+		Expect(out).ShouldNot(ContainSubstring("{"))
+	})
+
+	It("exports the stats of registered groups", func(testCtx context.Context) {
+		sut.Register("workers", group)
+
+		group.Go(func(ctx context.Context) error {
+			return nil
+		})
+
+		group.Go(func(ctx context.Context) error {
+			return errors.New("test error")
+		})
+
+		err, ok := group.WaitCtx(testCtx)
+		Expect(ok).Should(BeTrue())
+		Expect(err).Should(HaveOccurred())
+
+		out := export()
+
+		Expect(out).Should(ContainSubstring("jobgroup_jobs_started_total{group=\"workers\"} 2\n"))
+		Expect(out).Should(ContainSubstring("jobgroup_jobs_finished_total{group=\"workers\",outcome=\"completed\"} 1\n"))
+		Expect(out).Should(ContainSubstring("jobgroup_jobs_finished_total{group=\"workers\",outcome=\"failed\"} 1\n"))
+		Expect(out).Should(ContainSubstring("jobgroup_jobs_finished_total{group=\"workers\",outcome=\"panicked\"} 0\n"))
+		Expect(out).Should(ContainSubstring("jobgroup_jobs_finished_total{group=\"workers\",outcome=\"not_started\"} 0\n"))
+		Expect(out).Should(ContainSubstring("jobgroup_jobs_running{group=\"workers\"} 0\n"))
+		Expect(out).Should(ContainSubstring("jobgroup_jobs_waiting{group=\"workers\"} 0\n"))
+
+		Expect(out).Should(ContainSubstring("jobgroup_job_run_seconds_bucket{group=\"workers\",le=\"0.001\"} "))
+		Expect(out).Should(ContainSubstring("jobgroup_job_run_seconds_bucket{group=\"workers\",le=\"10\"} 2\n"))
+		Expect(out).Should(ContainSubstring("jobgroup_job_run_seconds_bucket{group=\"workers\",le=\"+Inf\"} 2\n"))
+		Expect(out).Should(ContainSubstring("jobgroup_job_run_seconds_count{group=\"workers\"} 2\n"))
+		Expect(out).Should(MatchRegexp(`jobgroup_job_run_seconds_sum\{group="workers"\} \S+\n`))
+		Expect(out).Should(ContainSubstring("jobgroup_job_wait_seconds_count{group=\"workers\"} 2\n"))
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	It("sorts groups by name", func() {
+		child := WithParent(group)
+		defer child.Close()
+
+		sut.Register("b", group)
+		sut.Register("a", child)
+
+		out := export()
+
+		Expect(strings.Index(out, `{group="a"}`)).Should(BeNumerically("<", strings.Index(out, `{group="b"}`)))
+	})
+
+	It("escapes group names", func() {
+		sut.Register("a \"quoted\"\\name\n", group)
+
+		Expect(export()).Should(ContainSubstring(`jobgroup_jobs_running{group="a \"quoted\"\\name\n"} 0`))
+	})
+
+	Describe("Register", func() {
+		It("panics if the name is taken", func() {
+			sut.Register("name", group)
+
+			Expect(func() { sut.Register("name", group) }).Should(PanicWith(`group "name" is already registered`))
+		})
+	})
+
+	Describe("Unregister", func() {
+		It("stops exporting the group", func() {
+			sut.Register("name", group)
+			sut.Unregister("name")
+			sut.Unregister("unknown")
+
+			Expect(export()).ShouldNot(ContainSubstring(`{group="name"}`))
+
+			// The name can be reused
+			sut.Register("name", group)
+		})
+	})
+
+	Describe("ServeHTTP", func() {
+		It("serves the text format", func() {
+			sut.Register("name", group)
+
+			rec := httptest.NewRecorder()
+			sut.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+			Expect(rec.Code).Should(Equal(http.StatusOK))
+			Expect(rec.Header().Get("Content-Type")).Should(HavePrefix("text/plain; version=0.0.4"))
+			Expect(rec.Body.String()).Should(Equal(export()))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package jobgroup
+
+import "context"
+
+// JobLabels are key/value pairs describing a job.
+type JobLabels map[string]string
+
+type jobMetaCtxKeyType struct{}
+
+var jobMetaCtxKey = new(jobMetaCtxKeyType) //nolint:gochecknoglobals
+
+// jobMeta is the identity of a job started with `GoNamed`.
+type jobMeta struct {
+	name   string
+	labels JobLabels
+}
+
+// JobNameFromCtx returns the name of the job `ctx` belongs to.
+//
+// If the job was started from a group created using the context of another job, the name is that of the
+// innermost named job.
+// The returned bool is false if the job was not started using `GoNamed`.
+func JobNameFromCtx(ctx context.Context) (string, bool) {
+	meta, ok := ctx.Value(jobMetaCtxKey).(*jobMeta)
+	if !ok {
+		return "", false
+	}
+
+	return meta.name, true
+}
+
+// JobLabelsFromCtx returns the labels of the job `ctx` belongs to.
+//
+// Like `JobNameFromCtx`, it uses the innermost named job.
+// It returns nil if the job was not started using `GoNamed`, or without labels.
+func JobLabelsFromCtx(ctx context.Context) JobLabels {
+	meta, ok := ctx.Value(jobMetaCtxKey).(*jobMeta)
+	if !ok {
+		return nil
+	}
+
+	return meta.labels
+}
+
+func (g *withContext) GoNamed(name string, labels JobLabels, userJob Job) {
+	job := bindJob(g.self, userJob)
+
+	job.meta = &jobMeta{
+		name:   name,
+		labels: labels,
+	}
+
+	g.self.launch(job)
+}
@@ -0,0 +1,118 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("named jobs", func() {
+	var sut JobGroup
+
+	BeforeEach(func() {
+		sut, _ = WithContext(context.Background())
+		DeferCleanup(sut.Close)
+	})
+
+	Describe("GoNamed", func() {
+		It("makes the name and labels available from the job's context", func(testCtx context.Context) {
+			labels := JobLabels{"key": "value"}
+
+			sut.GoNamed("test job", labels, func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				name, ok := JobNameFromCtx(ctx)
+				Expect(ok).Should(BeTrue())
+				Expect(name).Should(Equal("test job"))
+
+				Expect(JobLabelsFromCtx(ctx)).Should(Equal(labels))
+
+				return nil
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("wraps errors in a JobError", func(testCtx context.Context) {
+			expectedErr := errors.New("expected error")
+
+			sut.GoNamed("test job", nil, func(ctx context.Context) error {
+				return expectedErr
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(MatchError(expectedErr))
+
+			var jobErr *JobError
+			Expect(errors.As(err, &jobErr)).Should(BeTrue())
+			Expect(jobErr.Name()).Should(Equal("test job"))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("uses the group type's Go", func(testCtx context.Context) {
+			group := WithMaxConcurrency(sut, 1)
+			defer group.Close()
+
+			started := make(chan struct{})
+
+			group.GoNamed("job 1", nil, func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			Expect(group.TryGo(func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Fail("job should never run")
+
+				return nil
+			})).Should(BeFalse())
+
+			group.Cancel()
+
+			err, ok := group.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("names the job in reports", func(testCtx context.Context) {
+			started := make(chan struct{})
+
+			sut.GoNamed("test job", JobLabels{"key": "value"}, func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			jobs := downcastGroup(sut).(*withContext).running.info(false)
+			Expect(jobs).Should(HaveLen(1))
+			Expect(jobs[0].Name).Should(Equal("test job"))
+			Expect(jobs[0].Labels).Should(HaveKeyWithValue("key", "value"))
+
+			sut.Cancel()
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	Describe("JobNameFromCtx", func() {
+		It("returns false outside of a named job", func() {
+			_, ok := JobNameFromCtx(sut.Ctx())
+			Expect(ok).Should(BeFalse())
+		})
+	})
+
+	Describe("JobLabelsFromCtx", func() {
+		It("returns nil outside of a named job", func() {
+			Expect(JobLabelsFromCtx(sut.Ctx())).Should(BeNil())
+		})
+	})
+})
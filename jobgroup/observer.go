@@ -0,0 +1,129 @@
+package jobgroup
+
+import (
+	"context"
+	"time"
+)
+
+// Observer receives events about jobs of a group.
+//
+// Methods are called synchronously, from the job's goroutine except for `JobQueued`, so they should
+// be fast, and must be safe for concurrent use.
+// Embed `NopObserver` to only implement some methods.
+type Observer interface {
+	// JobQueued is called from the goroutine that submitted the job, before the job waits for any limit.
+	// For `TryGo` and `GoCtx`, it is called once the job is admitted: `job.Submitted` is still when
+	// the job was given to the group.
+	JobQueued(job JobInfo)
+
+	// JobStarted is called right before the job runs, `waited` after it was submitted, like `GroupStats.WaitTime`.
+	JobStarted(job JobInfo, waited time.Duration)
+
+	// JobFinished is called when the job returns, after running for `duration`.
+	JobFinished(job JobInfo, duration time.Duration, err error)
+
+	// JobPanicked is called instead of `JobFinished` when the job panics.
+	// `err` is a `JobPanicError`, or a `JobPanicsError` propagated from a child group.
+	JobPanicked(job JobInfo, err error)
+
+	// JobNotStarted is called instead of `JobStarted` and `JobFinished` when the job never ran,
+	// for example because the group's context ended while it waited for a limit.
+	JobNotStarted(job JobInfo, err error)
+}
+
+// NopObserver is an `Observer` that does nothing.
+type NopObserver struct{}
+
+var _ Observer = NopObserver{}
+
+func (NopObserver) JobQueued(JobInfo)                         {}
+func (NopObserver) JobStarted(JobInfo, time.Duration)         {}
+func (NopObserver) JobFinished(JobInfo, time.Duration, error) {}
+func (NopObserver) JobPanicked(JobInfo, error)                {}
+func (NopObserver) JobNotStarted(JobInfo, error)              {}
+
+type observersCtxKeyType struct{}
+
+var observersCtxKey = new(observersCtxKeyType) //nolint:gochecknoglobals
+
+// WithObserver returns a new `JobGroup`, child of `parent`, whose jobs are observed by `observer`.
+//
+// Like `WithPanicPolicy`, the observer is inherited by child groups.
+// Observers add up: jobs of a child group with its own observer are observed by both.
+func WithObserver(parent JobGroup, observer Observer) JobGroup {
+	prev := observersFromCtx(parent.Ctx())
+
+	// Copy so sibling groups don't share the backing array
+	observers := make([]Observer, 0, len(prev)+1)
+	observers = append(observers, prev...)
+	observers = append(observers, observer)
+
+	return withParentAndContext(parent, context.WithValue(parent.Ctx(), observersCtxKey, observers))
+}
+
+func observersFromCtx(ctx context.Context) []Observer {
+	observers, _ := ctx.Value(observersCtxKey).([]Observer)
+
+	return observers
+}
+
+// jobObservation is the state of an observed job.
+type jobObservation struct {
+	observers []Observer
+
+	info    JobInfo   // see queued
+	queued  time.Time // see queued
+	started time.Time // zero until the job starts
+}
+
+// observe makes the job notify `observers` of its events.
+func (j *boundJob) observe(observers []Observer) {
+	j.observation = &jobObservation{
+		observers: observers,
+
+		info:    JobInfo{},   // see jobQueued
+		queued:  time.Time{}, // see jobQueued
+		started: time.Time{}, // see jobStarted
+	}
+}
+
+func (o *jobObservation) jobQueued(job *boundJob) {
+	o.queued = job.submitted
+
+	o.info = job.info()
+
+	for _, observer := range o.observers {
+		observer.JobQueued(o.info)
+	}
+}
+
+func (o *jobObservation) jobStarted(now time.Time) {
+	o.started = now
+	o.info.Started = now
+
+	for _, observer := range o.observers {
+		observer.JobStarted(o.info, o.started.Sub(o.queued))
+	}
+}
+
+func (o *jobObservation) jobDone(err error) {
+	if o.started.IsZero() {
+		for _, observer := range o.observers {
+			observer.JobNotStarted(o.info, err)
+		}
+
+		return
+	}
+
+	duration := time.Since(o.started)
+
+	for _, observer := range o.observers {
+		observer.JobFinished(o.info, duration, err)
+	}
+}
+
+func (o *jobObservation) jobPanicked(err error) {
+	for _, observer := range o.observers {
+		observer.JobPanicked(o.info, err)
+	}
+}
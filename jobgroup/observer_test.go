@@ -0,0 +1,228 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ThinkChaos/parcour/zync"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// recordingObserver records events as strings.
+type recordingObserver struct {
+	events zync.Mutex[[]string]
+}
+
+func (o *recordingObserver) record(format string, args ...any) {
+	o.events.WithLock(func(events *[]string) {
+		*events = append(*events, fmt.Sprintf(format, args...))
+	})
+}
+
+func (o *recordingObserver) Events() []string {
+	var res []string
+
+	o.events.WithLock(func(events *[]string) {
+		res = append(res, *events...)
+	})
+
+	return res
+}
+
+func (o *recordingObserver) JobQueued(job JobInfo) {
+	o.record("queued %s", job.Name)
+}
+
+func (o *recordingObserver) JobStarted(job JobInfo, _ time.Duration) {
+	o.record("started %s", job.Name)
+}
+
+func (o *recordingObserver) JobFinished(job JobInfo, _ time.Duration, err error) {
+	o.record("finished %s: %v", job.Name, err)
+}
+
+func (o *recordingObserver) JobPanicked(job JobInfo, err error) {
+	o.record("panicked %s: %v", job.Name, err.(*JobPanicError).Value()) //nolint:forcetypeassert,errorlint
+}
+
+func (o *recordingObserver) JobNotStarted(job JobInfo, err error) {
+	o.record("not started %s", job.Name)
+}
+
+// waitObserver sends how long each job waited before starting.
+type waitObserver struct {
+	NopObserver
+
+	waited chan time.Duration
+}
+
+func (o *waitObserver) JobStarted(_ JobInfo, waited time.Duration) {
+	o.waited <- waited
+}
+
+var _ = Describe("Observer", func() {
+	var (
+		root JobGroup
+
+		sut         JobGroup
+		sutObserver *recordingObserver
+	)
+
+	BeforeEach(func() {
+		root, _ = WithContext(context.Background())
+		DeferCleanup(root.Close)
+
+		sutObserver = new(recordingObserver)
+
+		sut = WithObserver(root, sutObserver)
+		DeferCleanup(sut.Close)
+	})
+
+	Describe("WithObserver", func() {
+		It("creates a child group", func() {
+			casted := sut.(*withParent)
+			Expect(casted.parent).Should(BeIdenticalTo(root))
+		})
+
+		It("notifies of a job's lifecycle", func(testCtx context.Context) {
+			sut.GoNamed("job", nil, func(ctx context.Context) error {
+				return errors.New("test error")
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(HaveOccurred())
+
+			Expect(sutObserver.Events()).Should(Equal([]string{
+				"queued job",
+				"started job",
+				`finished job: job "job" failed: test error`,
+			}))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("notifies of panics", func(testCtx context.Context) {
+			sut.GoNamed("job", nil, func(ctx context.Context) error {
+				panic("panic value")
+			})
+
+			Expect(func() { sut.WaitCtx(testCtx) }).Should(Panic())
+
+			Expect(sutObserver.Events()).Should(Equal([]string{
+				"queued job",
+				"started job",
+				"panicked job: panic value",
+			}))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("notifies of jobs that didn't start", func(testCtx context.Context) {
+			sut.Cancel()
+
+			sut.GoNamed("job", nil, func(ctx context.Context) error {
+				return nil
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(errors.As(err, new(*JobNotStartedError))).Should(BeTrue())
+
+			Expect(sutObserver.Events()).Should(Equal([]string{
+				"queued job",
+				"not started job",
+			}))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("notifies once the job waited for limits", func(testCtx context.Context) {
+			child := WithMaxConcurrency(sut, 1)
+			defer child.Close()
+
+			started := make(chan struct{})
+
+			child.GoNamed("job 1", nil, func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			child.GoNamed("job 2", nil, func(ctx context.Context) error {
+				return nil
+			})
+
+			Eventually(testCtx, sutObserver.Events).Should(ContainElement("queued job 2"))
+			Consistently(sutObserver.Events, 20*time.Millisecond).ShouldNot(ContainElement("started job 2"))
+
+			child.Cancel()
+
+			err, ok := child.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(errors.As(err, new(*JobNotStartedError))).Should(BeTrue())
+
+			Expect(sutObserver.Events()).Should(ContainElement("not started job 2"))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("includes the time waited for admission", func(testCtx context.Context) {
+			observer := &waitObserver{
+				NopObserver: NopObserver{},
+				waited:      make(chan time.Duration, 2),
+			}
+
+			child := WithMaxConcurrency(WithObserver(sut, observer), 1)
+			defer child.Close()
+
+			jobCtx, jobEnd := context.WithCancel(testCtx)
+
+			child.Go(func(context.Context) error {
+				return blockUntilCtxDone(jobCtx)
+			})
+
+			Eventually(testCtx, observer.waited).Should(Receive())
+
+			time.AfterFunc(20*time.Millisecond, jobEnd)
+
+			Expect(child.GoCtx(testCtx, func(context.Context) error { return nil })).Should(Succeed())
+
+			var waited time.Duration
+			Eventually(testCtx, observer.waited).Should(Receive(&waited))
+			Expect(waited).Should(BeNumerically(">=", 20*time.Millisecond))
+
+			err, ok := child.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+			Expect(child.Stats().WaitTime).Should(BeNumerically(">=", waited))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("is inherited, and adds up with other observers", func(testCtx context.Context) {
+			childObserver := new(recordingObserver)
+
+			child := WithObserver(sut, childObserver)
+			defer child.Close()
+
+			child.GoNamed("job", nil, func(ctx context.Context) error {
+				return nil
+			})
+
+			err, ok := child.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			Expect(childObserver.Events()).Should(HaveLen(3))
+			Expect(sutObserver.Events()).Should(Equal(childObserver.Events()))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	Describe("NopObserver", func() {
+		It("does nothing", func() {
+			var sut Observer = NopObserver{}
+
+			sut.JobQueued(JobInfo{})
+			sut.JobStarted(JobInfo{}, 0)
+			sut.JobFinished(JobInfo{}, 0, nil)
+			sut.JobPanicked(JobInfo{}, nil)
+			sut.JobNotStarted(JobInfo{}, nil)
+		})
+	})
+})
@@ -0,0 +1,47 @@
+package jobgroup
+
+import "context"
+
+// PanicPolicy defines how a group handles job panics.
+type PanicPolicy int
+
+const (
+	// PropagatePanics propagates job panics to the goroutine waiting for the group.
+	// The panic value is a `JobPanicError`, or a `JobPanicsError` if multiple jobs panicked.
+	//
+	// This is the default.
+	PropagatePanics PanicPolicy = iota
+
+	// ConvertPanics converts job panics to errors: a `JobPanicError` is handled like
+	// any other job error.
+	ConvertPanics
+
+	// CrashOnPanic crashes the process when a job panics, like a panic in a goroutine
+	// not part of any group.
+	CrashOnPanic
+)
+
+type panicPolicyCtxKeyType struct{}
+
+var panicPolicyCtxKey = new(panicPolicyCtxKeyType) //nolint:gochecknoglobals
+
+// crash is used by `CrashOnPanic`. It is a variable for testing.
+var crash = func(err error) { panic(err) } //nolint:gochecknoglobals
+
+// WithPanicPolicy returns a new `JobGroup`, child of `parent`, that handles job panics according to `policy`.
+//
+// The policy applies to jobs of the returned group, and is inherited by its child groups,
+// including groups created using `WithContext` and the context of the group, or of one of its jobs.
+// A child group can set a different policy.
+func WithPanicPolicy(parent JobGroup, policy PanicPolicy) JobGroup {
+	return withParentAndContext(parent, context.WithValue(parent.Ctx(), panicPolicyCtxKey, policy))
+}
+
+func panicPolicyFromCtx(ctx context.Context) PanicPolicy {
+	policy, ok := ctx.Value(panicPolicyCtxKey).(PanicPolicy)
+	if !ok {
+		return PropagatePanics
+	}
+
+	return policy
+}
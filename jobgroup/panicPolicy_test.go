@@ -0,0 +1,109 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PanicPolicy", func() {
+	var root JobGroup
+
+	BeforeEach(func() {
+		root, _ = WithContext(context.Background())
+		DeferCleanup(root.Close)
+	})
+
+	Describe("WithPanicPolicy", func() {
+		It("creates a child group", func() {
+			sut := WithPanicPolicy(root, ConvertPanics)
+			defer sut.Close()
+
+			casted := sut.(*withParent)
+			Expect(casted.parent).Should(BeIdenticalTo(root))
+		})
+	})
+
+	When("the policy is PropagatePanics", func() {
+		It("propagates panics", func(testCtx context.Context) {
+			sut := WithPanicPolicy(root, PropagatePanics)
+
+			sut.Go(func(ctx context.Context) error {
+				panic("panic value")
+			})
+
+			Expect(sut.Close).Should(PanicWith(HaveField("Value()", "panic value")))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	When("the policy is ConvertPanics", func() {
+		It("converts panics to errors", func(testCtx context.Context) {
+			sut := WithPanicPolicy(root, ConvertPanics)
+			defer sut.Close()
+
+			sut.Go(func(ctx context.Context) error {
+				panic("panic value")
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(MatchError(ErrJobPanicked))
+
+			var panicErr *JobPanicError
+			Expect(errors.As(err, &panicErr)).Should(BeTrue())
+			Expect(panicErr.Value()).Should(Equal("panic value"))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("is inherited by child groups", func(testCtx context.Context) {
+			sut := WithPanicPolicy(root, ConvertPanics)
+			defer sut.Close()
+
+			child := WithMaxConcurrency(sut, 1)
+			defer child.Close()
+
+			child.Go(func(ctx context.Context) error {
+				panic("panic value")
+			})
+
+			err, ok := child.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(MatchError(ErrJobPanicked))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("can be overridden by child groups", func(testCtx context.Context) {
+			sut := WithPanicPolicy(root, ConvertPanics)
+			defer sut.Close()
+
+			child := WithPanicPolicy(sut, PropagatePanics)
+
+			child.Go(func(ctx context.Context) error {
+				panic("panic value")
+			})
+
+			Expect(child.Close).Should(PanicWith(MatchError(ErrJobPanicked)))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	When("the policy is CrashOnPanic", func() {
+		It("crashes", func(testCtx context.Context) {
+			crashed := make(chan error, 1)
+
+			prevCrash := crash
+			crash = func(err error) { crashed <- err }
+
+			DeferCleanup(func() { crash = prevCrash })
+
+			sut := WithPanicPolicy(root, CrashOnPanic)
+			defer sut.Close()
+
+			sut.Go(func(ctx context.Context) error {
+				panic("panic value")
+			})
+
+			Eventually(testCtx, crashed).Should(Receive(MatchError(ErrJobPanicked)))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+})
@@ -0,0 +1,53 @@
+package jobgroup
+
+// DefaultPriority is the priority of jobs started without an explicit priority.
+const DefaultPriority = 0
+
+var _ jobGroup = (*priorityMaxConcurrency)(nil)
+
+// PriorityJobGroup is a `JobGroup` whose jobs can have a priority.
+type PriorityJobGroup interface {
+	JobGroup
+
+	// GoWithPriority is like `Go`, but the job has the given priority.
+	//
+	// Jobs with a higher priority start first. Jobs with the same priority start in the order
+	// they were submitted.
+	// Jobs started with other methods, or from child groups, have `DefaultPriority`.
+	GoWithPriority(priority int, job Job)
+}
+
+type priorityMaxConcurrency struct {
+	fairMaxConcurrency
+}
+
+// WithPriorityMaxConcurrency is like `WithFairMaxConcurrency`, but jobs waiting to start are
+// ordered by priority first.
+//
+// If `max` is `NoConcurrencyLimit`, jobs never wait so priorities have no effect.
+func WithPriorityMaxConcurrency(parent JobGroup, max uint) PriorityJobGroup {
+	group := &priorityMaxConcurrency{
+		fairMaxConcurrency: fairMaxConcurrency{
+			withParent: newWithParent(parent),
+
+			sem: newSemaphore(limitToSemaphore(max)),
+		},
+	}
+
+	initGroup(parent.Ctx(), group)
+
+	return group
+}
+
+func (g *priorityMaxConcurrency) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *priorityMaxConcurrency) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithPriorityMaxConcurrency"
+	snapshot.Concurrency = g.sem.usage()
+}
+
+func (g *priorityMaxConcurrency) GoWithPriority(priority int, job Job) {
+	g.launchWith(bindJob(g, job), priority, DefaultCost)
+}
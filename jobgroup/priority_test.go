@@ -0,0 +1,169 @@
+package jobgroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThinkChaos/parcour/zync"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("priorityMaxConcurrency", func() {
+	var (
+		sutParent     *MockjobGroup
+		sutParentCtx  *identifiableContext
+		sutParentImpl jobGroup
+
+		sut      *priorityMaxConcurrency
+		sutLimit uint
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+
+		sutParentCtx = newIdentifiableContext(context.Background())
+		DeferCleanup(sutParentCtx.Cancel)
+
+		sutParent = NewMockjobGroup(ctrl)
+
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
+		sutParent.EXPECT().
+			Ctx().
+			Return(sutParentCtx)
+
+		group, _ := WithContext(sutParentCtx)
+		DeferCleanup(group.Close)
+
+		sutParentImpl = downcastGroup(group)
+
+		sutLimit = 1
+	})
+
+	JustBeforeEach(func() {
+		sut = WithPriorityMaxConcurrency(sutParent, sutLimit).(*priorityMaxConcurrency)
+		Expect(sut).ShouldNot(BeNil())
+		Expect(sut.parent).ShouldNot(BeNil())
+		Expect(sut.parent).Should(BeIdenticalTo(sutParent))
+
+		DeferCleanup(sut.Close)
+
+		sutParent.EXPECT().
+			launch(gomock.Any()).
+			AnyTimes().
+			Do(sutParentImpl.launch)
+	})
+
+	Describe("WithPriorityMaxConcurrency", func() {
+		When("the limit is `NoConcurrencyLimit`", func() {
+			BeforeEach(func() {
+				sutLimit = NoConcurrencyLimit
+			})
+
+			It("should not limit concurrency", func() {
+				sut.sem.state.WithLock(func(state *semaphoreState) {
+					Expect(state.limit).Should(Equal(noSemaphoreLimit))
+				})
+			})
+		})
+	})
+
+	Describe("GoWithPriority", func() {
+		It("starts the highest priority job first, and FIFO for ties", func(testCtx context.Context) {
+			blockCtx, unblock := context.WithCancel(testCtx)
+			started := make(chan struct{})
+
+			sut.Go(func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(blockCtx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			order := zync.NewMutex([]string(nil))
+			job := func(name string) Job {
+				return func(context.Context) error {
+					order.WithLock(func(order *[]string) {
+						*order = append(*order, name)
+					})
+
+					return nil
+				}
+			}
+
+			sut.GoWithPriority(-1, job("background 1"))
+			sut.Go(job("default 1"))
+			sut.GoWithPriority(10, job("interactive 1"))
+			sut.GoWithPriority(-1, job("background 2"))
+			sut.GoWithPriority(10, job("interactive 2"))
+			sut.Go(job("default 2"))
+
+			unblock()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			order.WithLock(func(order *[]string) {
+				Expect(*order).Should(Equal([]string{
+					"interactive 1", "interactive 2",
+					"default 1", "default 2",
+					"background 1", "background 2",
+				}))
+			})
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("gives jobs from child groups the default priority", func(testCtx context.Context) {
+			blockCtx, unblock := context.WithCancel(testCtx)
+			started := make(chan struct{})
+
+			sut.Go(func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(blockCtx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			child := WithParent(sut)
+			defer child.Close()
+
+			order := zync.NewMutex([]string(nil))
+			job := func(name string) Job {
+				return func(context.Context) error {
+					order.WithLock(func(order *[]string) {
+						*order = append(*order, name)
+					})
+
+					return nil
+				}
+			}
+
+			sut.GoWithPriority(-1, job("low"))
+			child.Go(job("child"))
+
+			unblock()
+
+			err, ok := child.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			err, ok = sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			order.WithLock(func(order *[]string) {
+				Expect(*order).Should(Equal([]string{"child", "low"}))
+			})
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+})
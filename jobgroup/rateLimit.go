@@ -0,0 +1,181 @@
+package jobgroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThinkChaos/parcour/zync"
+)
+
+var _ jobGroup = (*rateLimit)(nil)
+
+type rateLimit struct {
+	withParent
+
+	bucket zync.Mutex[tokenBucket]
+}
+
+// WithRateLimit returns a new `JobGroup`, child of `parent`, that limits the rate at which jobs start.
+//
+// The limit is a token bucket: a token is added every `every`, up to `burst` tokens.
+// Each job consumes a token before starting, and waits for one if there are none left.
+// The bucket starts full, so up to `burst` jobs can start immediately.
+//
+// If `every` is 0, then this function is equivalent to `WithParent`.
+// A `burst` of 0 is treated as 1.
+//
+// The limit applies to job starts only: it doesn't bound the number of concurrent jobs.
+// Use `WithMaxConcurrency` for that.
+func WithRateLimit(parent JobGroup, every time.Duration, burst uint) JobGroup {
+	if every == 0 {
+		return WithParent(parent)
+	}
+
+	if burst == 0 {
+		burst = 1
+	}
+
+	return initGroup(parent.Ctx(), &rateLimit{
+		withParent: newWithParent(parent),
+
+		bucket: zync.NewMutex(newTokenBucket(every, burst, time.Now())),
+	})
+}
+
+func (g *rateLimit) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *rateLimit) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithRateLimit"
+}
+
+func (g *rateLimit) admit(ctx context.Context, job *boundJob) error {
+	err := g.wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = g.withParent.admit(ctx, job)
+	if err != nil {
+		g.cancelToken()
+
+		return err
+	}
+
+	return nil
+}
+
+func (g *rateLimit) launch(job *boundJob) {
+	job.Wrap(func(userJob Job) Job {
+		return func(ctx context.Context) error {
+			if job.admitted {
+				return userJob(ctx) // token was taken by `admit`
+			}
+
+			err := g.wait(ctx)
+			if err != nil {
+				return newJobNotStartedError(err)
+			}
+
+			return userJob(ctx)
+		}
+	})
+
+	g.withParent.launch(job)
+}
+
+// wait blocks until a token was taken from the bucket, or `ctx` ends.
+func (g *rateLimit) wait(ctx context.Context) error {
+	var delay time.Duration
+
+	g.bucket.WithLock(func(bucket *tokenBucket) {
+		delay = bucket.reserve(time.Now())
+	})
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+
+	case <-ctx.Done():
+		// Give the token back so other jobs don't have to wait for it.
+		g.cancelToken()
+
+		return ctx.Err()
+	}
+}
+
+// cancelToken returns a token taken by `wait`.
+func (g *rateLimit) cancelToken() {
+	g.bucket.WithLock(func(bucket *tokenBucket) {
+		bucket.cancel(time.Now())
+	})
+}
+
+// tokenBucket is the state of a rate limiter.
+//
+// Tokens can go negative: that means jobs reserved tokens that are not available yet,
+// and are waiting for them.
+type tokenBucket struct {
+	every time.Duration
+	burst float64
+
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(every time.Duration, burst uint, now time.Time) tokenBucket {
+	return tokenBucket{
+		every: every,
+		burst: float64(burst),
+
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+// reserve takes a token and returns how long to wait before it is available.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.refill(now)
+
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens * float64(b.every))
+}
+
+// cancel returns a token previously taken using `reserve`.
+func (b *tokenBucket) cancel(now time.Time) {
+	b.refill(now)
+
+	b.tokens++
+
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last)
+	if elapsed <= 0 {
+		return
+	}
+
+	b.last = now
+
+	b.tokens += float64(elapsed) / float64(b.every)
+
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
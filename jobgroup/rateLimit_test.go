@@ -0,0 +1,272 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("rateLimit", func() {
+	var (
+		sutParent     *MockjobGroup
+		sutParentCtx  *identifiableContext
+		sutParentImpl jobGroup
+
+		sut      *rateLimit
+		sutEvery time.Duration
+		sutBurst uint
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+
+		sutParentCtx = newIdentifiableContext(context.Background())
+		DeferCleanup(sutParentCtx.Cancel)
+
+		sutParent = NewMockjobGroup(ctrl)
+
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
+		sutParent.EXPECT().
+			Ctx().
+			Return(sutParentCtx)
+
+		group, _ := WithContext(sutParentCtx)
+		DeferCleanup(group.Close)
+
+		sutParentImpl = downcastGroup(group)
+
+		sutEvery = 50 * time.Millisecond
+		sutBurst = 1
+	})
+
+	JustBeforeEach(func() {
+		sut = WithRateLimit(sutParent, sutEvery, sutBurst).(*rateLimit)
+		Expect(sut).ShouldNot(BeNil())
+		Expect(sut.parent).ShouldNot(BeNil())
+		Expect(sut.parent).Should(BeIdenticalTo(sutParent))
+
+		DeferCleanup(sut.Close)
+	})
+
+	Describe("WithRateLimit", func() {
+		When("`every` is 0", func() {
+			It("should not limit the rate", func() {
+				sutParent.EXPECT().
+					Ctx().
+					Return(sutParentCtx)
+
+				group := WithRateLimit(sutParent, 0, 1)
+				defer group.Close()
+
+				casted := group.(*withParent)
+				Expect(casted).ShouldNot(BeNil())
+				Expect(casted.parent).Should(BeIdenticalTo(sutParent))
+			})
+		})
+
+		When("`burst` is 0", func() {
+			BeforeEach(func() {
+				sutBurst = 0
+			})
+
+			It("should allow a single token", func() {
+				sut.bucket.WithLock(func(bucket *tokenBucket) {
+					Expect(bucket.burst).Should(BeNumerically("==", 1))
+					Expect(bucket.tokens).Should(BeNumerically("==", 1))
+				})
+			})
+		})
+	})
+
+	Describe("Go", func() {
+		It("should call parent.launch", func() {
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			sut.Go(func(ctx context.Context) error {
+				return nil
+			})
+
+			Expect(sut.Wait()).Should(Succeed())
+		})
+
+		When("the burst is available", func() {
+			BeforeEach(func() {
+				sutEvery = time.Hour
+				sutBurst = 3
+			})
+
+			It("starts jobs immediately", func(testCtx context.Context) {
+				sutParent.EXPECT().
+					launch(gomock.Any()).
+					Times(int(sutBurst)).
+					Do(sutParentImpl.launch)
+
+				started := make(chan struct{})
+
+				for i := uint(0); i < sutBurst; i++ {
+					sut.Go(func(ctx context.Context) error {
+						started <- struct{}{}
+
+						return nil
+					})
+				}
+
+				for i := uint(0); i < sutBurst; i++ {
+					Eventually(testCtx, started).Should(Receive())
+				}
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(Succeed())
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+
+		When("the bucket is empty", func() {
+			It("delays jobs", func(testCtx context.Context) {
+				sutParent.EXPECT().
+					launch(gomock.Any()).
+					Times(2).
+					Do(sutParentImpl.launch)
+
+				starts := make(chan time.Time, 2)
+				job := func(ctx context.Context) error {
+					starts <- time.Now()
+
+					return nil
+				}
+
+				sut.Go(job)
+				sut.Go(job)
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(Succeed())
+
+				first, second := <-starts, <-starts
+				Expect(second.Sub(first)).Should(BeNumerically("~", sutEvery, sutEvery/2))
+			}, SpecTimeout(500*time.Millisecond*timeoutFactor))
+
+			It("stops waiting if the group is cancelled", func(testCtx context.Context) {
+				sutParent.EXPECT().
+					launch(gomock.Any()).
+					Times(2).
+					Do(sutParentImpl.launch)
+
+				sutEvery = time.Hour
+				sut.bucket.WithLock(func(bucket *tokenBucket) {
+					bucket.every = sutEvery
+				})
+
+				started := make(chan struct{})
+
+				sut.Go(func(ctx context.Context) error {
+					close(started)
+
+					return nil
+				})
+
+				Eventually(testCtx, started).Should(BeClosed())
+
+				sut.Go(func(ctx context.Context) error {
+					defer GinkgoRecover()
+
+					Fail("job 2 should never run")
+
+					return nil
+				})
+
+				Consistently(sut.Ctx().Err, 20*time.Millisecond).Should(Succeed())
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue()) // false is test timeout
+				Expect(err).ShouldNot(Succeed())
+				Expect(errors.As(err, new(*JobNotStartedError))).Should(BeTrue())
+
+				By("returning the reserved token", func() {
+					sut.bucket.WithLock(func(bucket *tokenBucket) {
+						Expect(bucket.tokens).Should(BeNumerically("~", 0, 0.01))
+					})
+				})
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+	})
+
+	Describe("TryGo", func() {
+		BeforeEach(func() {
+			sutEvery = time.Hour
+		})
+
+		It("refuses the job when there is no token", func(testCtx context.Context) {
+			sutParent.EXPECT().
+				admit(gomock.Any(), gomock.Any()).
+				DoAndReturn(sutParentImpl.admit)
+
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			Expect(sut.TryGo(func(ctx context.Context) error { return nil })).Should(BeTrue())
+
+			Expect(sut.TryGo(func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Fail("job should never run")
+
+				return nil
+			})).Should(BeFalse())
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			By("not keeping a token for the refused job", func() {
+				sut.bucket.WithLock(func(bucket *tokenBucket) {
+					Expect(bucket.tokens).Should(BeNumerically("~", 0, 0.01))
+				})
+			})
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	Describe("tokenBucket", func() {
+		It("refills over time", func() {
+			now := time.Now()
+			bucket := newTokenBucket(time.Second, 2, now)
+
+			Expect(bucket.reserve(now)).Should(BeZero())
+			Expect(bucket.reserve(now)).Should(BeZero())
+			Expect(bucket.reserve(now)).Should(Equal(time.Second))
+			Expect(bucket.reserve(now)).Should(Equal(2 * time.Second))
+
+			now = now.Add(2 * time.Second)
+			Expect(bucket.reserve(now)).Should(Equal(time.Second))
+		})
+
+		It("does not exceed the burst", func() {
+			now := time.Now()
+			bucket := newTokenBucket(time.Second, 1, now)
+
+			now = now.Add(time.Hour)
+			Expect(bucket.reserve(now)).Should(BeZero())
+			Expect(bucket.reserve(now)).Should(Equal(time.Second))
+
+			bucket.cancel(now)
+			bucket.cancel(now)
+			Expect(bucket.tokens).Should(BeNumerically("==", 1))
+		})
+	})
+})
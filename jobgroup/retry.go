@@ -0,0 +1,161 @@
+package jobgroup
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// NoRetryLimit is used to signify jobs should be retried until they succeed, or the group ends.
+const NoRetryLimit = 0
+
+// defaultMaxRetryErrors is the `RetryPolicy.MaxErrors` used when none is given and there is no retry limit.
+const defaultMaxRetryErrors = 10
+
+// DefaultRetryBackoff is the `RetryPolicy.Backoff` used when none is given.
+var DefaultRetryBackoff = ExponentialBackoff(10*time.Millisecond, time.Second) //nolint:gochecknoglobals
+
+var _ jobGroup = (*retry)(nil)
+
+// RetryPolicy configures how jobs of a `WithRetry` group are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a job is run, including the first attempt.
+	//
+	// If it is `NoRetryLimit`, jobs are retried until they succeed or the group's context ends.
+	MaxAttempts uint
+
+	// Backoff returns how long to wait before the given retry.
+	// `retry` is 1 for the first retry, which is the second attempt.
+	//
+	// If it is nil, `DefaultRetryBackoff` is used, so failing jobs don't retry in a busy loop.
+	Backoff func(retry uint) time.Duration
+
+	// Retryable reports whether a job that returned `err` should be retried.
+	//
+	// If it is nil, all errors are retried.
+	Retryable func(err error) bool
+
+	// MaxErrors is the maximum number of attempt errors kept by the `RetryError`: only the last
+	// ones are kept, so memory doesn't grow with retries.
+	//
+	// If it is 0, the error of each attempt is kept when `MaxAttempts` is set, and the last 10 otherwise.
+	MaxErrors uint
+}
+
+// ExponentialBackoff returns a `RetryPolicy.Backoff` or `SupervisorConfig.Backoff` that doubles the delay
+// on each retry, starting at `initial`, and never exceeding `max`.
+//
+// Some jitter is applied to each delay: the actual delay is at least half of the computed delay.
+// This avoids jobs that failed at the same time retrying in lockstep.
+func ExponentialBackoff(initial, max time.Duration) func(retry uint) time.Duration {
+	return func(retry uint) time.Duration {
+		delay := initial
+
+		for i := uint(1); i < retry && delay < max; i++ {
+			delay *= 2
+		}
+
+		if delay > max {
+			delay = max
+		}
+
+		half := delay / 2
+
+		return half + time.Duration(rand.Int63n(int64(half)+1)) //nolint:gosec // jitter doesn't need crypto
+	}
+}
+
+type retry struct {
+	withParent
+
+	policy RetryPolicy
+}
+
+// WithRetry returns a new `JobGroup`, child of `parent`, that re-runs jobs that return an error.
+//
+// Retries stop once the job succeeds, the policy's limit is reached, the error is not
+// retryable, or the group's context ends.
+// In the last case, a job waiting to be retried stops waiting immediately.
+//
+// If a job does not succeed, its error is a `RetryError` that wraps the error of the last attempts.
+func WithRetry(parent JobGroup, policy RetryPolicy) JobGroup {
+	if policy.Backoff == nil {
+		policy.Backoff = DefaultRetryBackoff
+	}
+
+	if policy.MaxErrors == 0 && policy.MaxAttempts == NoRetryLimit {
+		policy.MaxErrors = defaultMaxRetryErrors
+	}
+
+	return initGroup(parent.Ctx(), &retry{
+		withParent: newWithParent(parent),
+
+		policy: policy,
+	})
+}
+
+func (g *retry) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *retry) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithRetry"
+}
+
+func (g *retry) launch(job *boundJob) {
+	job.Wrap(func(userJob Job) Job {
+		return func(ctx context.Context) error {
+			var errs []error
+
+			for attempt := uint(1); ; attempt++ {
+				err := userJob(ctx)
+				if err == nil {
+					return nil
+				}
+
+				if g.policy.MaxErrors != 0 && uint(len(errs)) == g.policy.MaxErrors {
+					// Only keep the last errors, see `RetryPolicy.MaxErrors`
+					copy(errs, errs[1:])
+					errs = errs[:len(errs)-1]
+				}
+
+				errs = append(errs, err)
+
+				if !g.shouldRetry(ctx, attempt, err) {
+					return newRetryError(attempt, errs)
+				}
+			}
+		}
+	})
+
+	g.withParent.launch(job)
+}
+
+// shouldRetry reports whether the job should be retried after its `attempt` failed with `err`.
+//
+// It blocks for the backoff duration.
+func (g *retry) shouldRetry(ctx context.Context, attempt uint, err error) bool {
+	if g.policy.MaxAttempts != NoRetryLimit && attempt >= g.policy.MaxAttempts {
+		return false
+	}
+
+	if g.policy.Retryable != nil && !g.policy.Retryable(err) {
+		return false
+	}
+
+	delay := g.policy.Backoff(attempt)
+	if delay <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return ctx.Err() == nil
+
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,41 @@
+package jobgroup
+
+import "fmt"
+
+// RetryError is returned when a job of a `WithRetry` group did not succeed.
+//
+// It wraps the error of each attempt, or of the last ones according to `RetryPolicy.MaxErrors`,
+// so `errors.Is` and `errors.As` match any of them.
+type RetryError struct {
+	count    uint
+	attempts []error
+}
+
+func newRetryError(count uint, attempts []error) *RetryError {
+	return &RetryError{count: count, attempts: attempts}
+}
+
+// Error implements `error`.
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("job failed after %d attempt(s): %v", e.count, e.Last())
+}
+
+// Unwrap implements the interface expected by `errors.Is` and `errors.As`.
+func (e *RetryError) Unwrap() []error {
+	return e.attempts
+}
+
+// Count returns the number of attempts, which can be more than the number of errors kept.
+func (e *RetryError) Count() uint {
+	return e.count
+}
+
+// Attempts returns the error of the last attempts, in order.
+func (e *RetryError) Attempts() []error {
+	return e.attempts
+}
+
+// Last returns the error of the last attempt.
+func (e *RetryError) Last() error {
+	return e.attempts[len(e.attempts)-1]
+}
@@ -0,0 +1,49 @@
+package jobgroup
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RetryError", func() {
+	var (
+		inner1, inner2 error
+
+		sut *RetryError
+	)
+
+	BeforeEach(func() {
+		inner1 = errors.New("test inner error 1")
+		inner2 = errors.New("test inner error 2")
+
+		sut = newRetryError(2, []error{inner1, inner2})
+	})
+
+	Describe("Error", func() {
+		It("contains the attempt count and last error", func() {
+			Expect(sut.Error()).Should(ContainSubstring("2 attempt(s)"))
+			Expect(sut.Error()).Should(ContainSubstring(inner2.Error()))
+		})
+	})
+
+	Describe("Unwrap", func() {
+		It("returns all attempt errors", func() {
+			Expect(sut.Unwrap()).Should(HaveExactElements(inner1, inner2))
+			Expect(sut).Should(SatisfyAll(MatchError(inner1), MatchError(inner2)))
+		})
+	})
+
+	Describe("Count", func() {
+		It("returns the attempt count", func() {
+			Expect(sut.Count()).Should(BeNumerically("==", 2))
+		})
+	})
+
+	Describe("Last", func() {
+		It("returns the last attempt error", func() {
+			Expect(sut.Last()).Should(BeIdenticalTo(inner2))
+		})
+	})
+})
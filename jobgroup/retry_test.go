@@ -0,0 +1,277 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("retry", func() {
+	var (
+		sutParent     *MockjobGroup
+		sutParentCtx  *identifiableContext
+		sutParentImpl jobGroup
+
+		sut       *retry
+		sutPolicy RetryPolicy
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+
+		sutParentCtx = newIdentifiableContext(context.Background())
+		DeferCleanup(sutParentCtx.Cancel)
+
+		sutParent = NewMockjobGroup(ctrl)
+
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
+		sutParent.EXPECT().
+			Ctx().
+			Return(sutParentCtx)
+
+		group, _ := WithContext(sutParentCtx)
+		DeferCleanup(group.Close)
+
+		sutParentImpl = downcastGroup(group)
+
+		sutPolicy = RetryPolicy{MaxAttempts: 3}
+	})
+
+	JustBeforeEach(func() {
+		sut = WithRetry(sutParent, sutPolicy).(*retry)
+		Expect(sut).ShouldNot(BeNil())
+		Expect(sut.parent).ShouldNot(BeNil())
+		Expect(sut.parent).Should(BeIdenticalTo(sutParent))
+
+		DeferCleanup(sut.Close)
+
+		sutParent.EXPECT().
+			launch(gomock.Any()).
+			AnyTimes().
+			Do(sutParentImpl.launch)
+	})
+
+	Describe("Go", func() {
+		It("runs a successful job once", func() {
+			var runs atomic.Int32
+
+			sut.Go(func(ctx context.Context) error {
+				runs.Add(1)
+
+				return nil
+			})
+
+			Expect(sut.Wait()).Should(Succeed())
+			Expect(runs.Load()).Should(BeNumerically("==", 1))
+		})
+
+		It("retries until the job succeeds", func() {
+			var runs atomic.Int32
+
+			sut.Go(func(ctx context.Context) error {
+				if runs.Add(1) < 2 {
+					return errors.New("not yet")
+				}
+
+				return nil
+			})
+
+			Expect(sut.Wait()).Should(Succeed())
+			Expect(runs.Load()).Should(BeNumerically("==", 2))
+		})
+
+		It("stops after MaxAttempts and wraps all errors", func() {
+			var runs atomic.Int32
+
+			expectedErrs := []error{
+				errors.New("expected error 1"),
+				errors.New("expected error 2"),
+				errors.New("expected error 3"),
+			}
+
+			sut.Go(func(ctx context.Context) error {
+				return expectedErrs[runs.Add(1)-1]
+			})
+
+			err := sut.Wait()
+			Expect(runs.Load()).Should(BeNumerically("==", sutPolicy.MaxAttempts))
+			Expect(err).Should(SatisfyAll(
+				MatchError(expectedErrs[0]),
+				MatchError(expectedErrs[1]),
+				MatchError(expectedErrs[2]),
+			))
+
+			var retryErr *RetryError
+			Expect(errors.As(err, &retryErr)).Should(BeTrue())
+			Expect(retryErr.Attempts()).Should(HaveExactElements(expectedErrs))
+		})
+
+		When("there are many attempts", func() {
+			var (
+				runs         atomic.Int32
+				expectedErrs []error
+			)
+
+			BeforeEach(func() {
+				sutPolicy.MaxAttempts = 15
+				sutPolicy.Backoff = func(uint) time.Duration { return 0 }
+
+				runs.Store(0)
+
+				expectedErrs = make([]error, 15)
+				for i := range expectedErrs {
+					expectedErrs[i] = errors.New("expected error")
+				}
+			})
+
+			JustBeforeEach(func() {
+				sut.Go(func(ctx context.Context) error {
+					return expectedErrs[runs.Add(1)-1]
+				})
+			})
+
+			It("keeps all errors", func() {
+				err := sut.Wait()
+
+				var retryErr *RetryError
+				Expect(errors.As(err, &retryErr)).Should(BeTrue())
+				Expect(retryErr.Count()).Should(BeNumerically("==", 15))
+				Expect(retryErr.Attempts()).Should(HaveExactElements(expectedErrs))
+			})
+
+			When("MaxErrors is set", func() {
+				BeforeEach(func() {
+					sutPolicy.MaxErrors = 4
+				})
+
+				It("only keeps the last errors", func() {
+					err := sut.Wait()
+
+					var retryErr *RetryError
+					Expect(errors.As(err, &retryErr)).Should(BeTrue())
+					Expect(retryErr.Count()).Should(BeNumerically("==", 15))
+					Expect(retryErr.Attempts()).Should(HaveExactElements(expectedErrs[11:]))
+					Expect(retryErr.Error()).Should(ContainSubstring("15 attempt(s)"))
+				})
+			})
+
+			When("there is no retry limit", func() {
+				BeforeEach(func() {
+					sutPolicy.MaxAttempts = NoRetryLimit
+					sutPolicy.Retryable = func(error) bool {
+						return runs.Load() < 15
+					}
+				})
+
+				It("only keeps the last 10 errors by default", func() {
+					err := sut.Wait()
+
+					var retryErr *RetryError
+					Expect(errors.As(err, &retryErr)).Should(BeTrue())
+					Expect(retryErr.Count()).Should(BeNumerically("==", 15))
+					Expect(retryErr.Attempts()).Should(HaveExactElements(expectedErrs[5:]))
+				})
+			})
+		})
+
+		When("the error is not retryable", func() {
+			var permanentErr error
+
+			BeforeEach(func() {
+				permanentErr = errors.New("permanent error")
+
+				sutPolicy.Retryable = func(err error) bool {
+					return !errors.Is(err, permanentErr)
+				}
+			})
+
+			It("does not retry", func() {
+				var runs atomic.Int32
+
+				sut.Go(func(ctx context.Context) error {
+					runs.Add(1)
+
+					return permanentErr
+				})
+
+				Expect(sut.Wait()).Should(MatchError(permanentErr))
+				Expect(runs.Load()).Should(BeNumerically("==", 1))
+			})
+		})
+
+		When("there is a backoff", func() {
+			BeforeEach(func() {
+				sutPolicy.MaxAttempts = NoRetryLimit
+				sutPolicy.Backoff = func(uint) time.Duration { return time.Hour }
+			})
+
+			It("stops waiting when the group is cancelled", func(testCtx context.Context) {
+				expectedErr := errors.New("expected error")
+				started := make(chan struct{})
+
+				sut.Go(func(ctx context.Context) error {
+					close(started)
+
+					return expectedErr
+				})
+
+				Eventually(testCtx, started).Should(BeClosed())
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue()) // false is test timeout
+				Expect(err).Should(MatchError(expectedErr))
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+	})
+
+	When("there is no backoff", func() {
+		BeforeEach(func() {
+			sutPolicy.MaxAttempts = NoRetryLimit
+		})
+
+		It("uses DefaultRetryBackoff", func(testCtx context.Context) {
+			var runs atomic.Int32
+
+			sut.Go(func(ctx context.Context) error {
+				runs.Add(1)
+
+				return errors.New("test error")
+			})
+
+			Consistently(testCtx, runs.Load).
+				WithTimeout(50 * time.Millisecond).
+				Should(BeNumerically("<", 10))
+
+			sut.Cancel()
+
+			_, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	Describe("ExponentialBackoff", func() {
+		It("doubles the delay up to the max", func() {
+			backoff := ExponentialBackoff(10*time.Millisecond, 50*time.Millisecond)
+
+			Expect(backoff(1)).Should(BeNumerically("~", 7500*time.Microsecond, 2500*time.Microsecond))
+			Expect(backoff(2)).Should(BeNumerically("~", 15*time.Millisecond, 5*time.Millisecond))
+			Expect(backoff(3)).Should(BeNumerically("~", 30*time.Millisecond, 10*time.Millisecond))
+			Expect(backoff(4)).Should(BeNumerically("~", 37500*time.Microsecond, 12500*time.Microsecond))
+			Expect(backoff(100)).Should(BeNumerically("~", 37500*time.Microsecond, 12500*time.Microsecond))
+		})
+	})
+})
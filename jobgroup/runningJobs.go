@@ -0,0 +1,151 @@
+package jobgroup
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/ThinkChaos/parcour/zync"
+)
+
+// JobInfo describes a job that is part of a group.
+type JobInfo struct {
+	// Name identifies the job: it is the name given to `GoNamed`, or the name of the job's function.
+	Name string
+
+	// Labels are the labels given to `GoNamed`.
+	Labels JobLabels
+
+	// Submitted is when the job was given to the group.
+	Submitted time.Time
+
+	// Started is when the job started running, after waiting for any limits.
+	// It is zero while the job is waiting.
+	Started time.Time
+
+	// Stack is the stack trace of the job's goroutine.
+	// It is only set in reports about stuck jobs, such as `StuckJobsError`.
+	Stack string
+}
+
+// runningJobs tracks the jobs a group waits for.
+type runningJobs struct {
+	zync.Mutex[map[*boundJob]struct{}]
+}
+
+func newRunningJobs() runningJobs {
+	return runningJobs{
+		Mutex: zync.NewMutex(make(map[*boundJob]struct{})),
+	}
+}
+
+// add tracks `job` until it is done.
+func (r *runningJobs) add(job *boundJob) {
+	r.WithLock(func(jobs *map[*boundJob]struct{}) {
+		(*jobs)[job] = struct{}{}
+	})
+
+	job.Defer(func() {
+		r.WithLock(func(jobs *map[*boundJob]struct{}) {
+			delete(*jobs, job)
+		})
+	})
+}
+
+// info returns the description of each tracked job, oldest first.
+//
+// Collecting stacks briefly stops all goroutines, so it should only be done for reports.
+func (r *runningJobs) info(withStacks bool) []JobInfo {
+	var (
+		res    []JobInfo
+		stacks map[uint64]string
+	)
+
+	if withStacks {
+		stacks = goroutineStacks()
+	}
+
+	r.WithLock(func(jobs *map[*boundJob]struct{}) {
+		res = make([]JobInfo, 0, len(*jobs))
+
+		for job := range *jobs {
+			info := job.info()
+			info.Stack = stacks[job.goroutine.Load()]
+
+			res = append(res, info)
+		}
+	})
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Submitted.Before(res[j].Submitted)
+	})
+
+	return res
+}
+
+// snapshot returns the snapshot of each tracked job bound to `group`, oldest first.
+func (r *runningJobs) snapshot(group jobGroup) []JobSnapshot {
+	var res []JobSnapshot
+
+	r.WithLock(func(jobs *map[*boundJob]struct{}) {
+		for job := range *jobs {
+			if job.group != group {
+				continue // part of a child group
+			}
+
+			res = append(res, JobSnapshot{
+				JobInfo: job.info(),
+			})
+		}
+	})
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Submitted.Before(res[j].Submitted)
+	})
+
+	return res
+}
+
+// info returns the description of the job, without its stack.
+func (j *boundJob) info() JobInfo {
+	var started time.Time
+	if nanos := j.runningSince.Load(); nanos != 0 {
+		started = time.Unix(0, nanos)
+	}
+
+	return JobInfo{
+		Name:      j.name(),
+		Labels:    j.labels(),
+		Submitted: j.submitted,
+		Started:   started,
+		Stack:     "",
+	}
+}
+
+// name returns the name of the job, or of the job's function if it has none.
+func (j *boundJob) name() string {
+	if j.meta != nil {
+		return j.meta.name
+	}
+
+	return funcName(j.userJob)
+}
+
+func (j *boundJob) labels() JobLabels {
+	if j.meta == nil {
+		return nil
+	}
+
+	return j.meta.labels
+}
+
+// funcName returns the name of `job`'s function.
+func funcName(job Job) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(job).Pointer())
+	if fn == nil {
+		return "unknown"
+	}
+
+	return fn.Name()
+}
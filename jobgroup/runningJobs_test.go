@@ -0,0 +1,49 @@
+package jobgroup
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("runningJobs", func() {
+	It("tracks jobs until they are done", func(testCtx context.Context) {
+		group, _ := WithContext(context.Background())
+		defer group.Close()
+
+		casted := downcastGroup(group).(*withContext)
+
+		child := WithParent(group)
+		defer child.Close()
+
+		before := time.Now()
+
+		started := make(chan struct{})
+
+		child.Go(func(ctx context.Context) error {
+			close(started)
+
+			return blockUntilCtxDone(ctx)
+		})
+
+		Eventually(testCtx, started).Should(BeClosed())
+
+		for _, running := range []*runningJobs{&casted.running, &downcastGroup(child).(*withParent).running} {
+			jobs := running.info(false)
+			Expect(jobs).Should(HaveLen(1))
+			Expect(jobs[0].Name).Should(HavePrefix("github.com/ThinkChaos/parcour/jobgroup."))
+			Expect(jobs[0].Submitted).ShouldNot(BeTemporally("<", before))
+			Expect(jobs[0].Started).ShouldNot(BeTemporally("<", jobs[0].Submitted))
+		}
+
+		group.Cancel()
+
+		err, ok := group.WaitCtx(testCtx)
+		Expect(ok).Should(BeTrue())
+		Expect(err).Should(Succeed())
+
+		Expect(casted.running.info(false)).Should(BeEmpty())
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+})
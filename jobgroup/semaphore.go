@@ -0,0 +1,225 @@
+package jobgroup
+
+import (
+	"container/heap"
+	"context"
+
+	"github.com/ThinkChaos/parcour/zync"
+)
+
+// semaphore is a weighted semaphore that admits waiters by priority, and then in the order they queued.
+//
+// Waiters are admitted strictly in queue order: if the next waiter doesn't fit, the ones
+// behind it wait too, even if they would fit. This ensures heavy waiters don't starve.
+type semaphore struct {
+	state zync.Mutex[semaphoreState]
+}
+
+type semaphoreState struct {
+	limit uint
+	used  uint
+
+	waiters ticketQueue
+	nextSeq uint64
+}
+
+// semaphoreTicket is a place in the queue of a `semaphore`.
+type semaphoreTicket struct {
+	sem *semaphore
+
+	priority int
+	weight   uint
+	seq      uint64
+
+	ready chan struct{} // closed once granted
+
+	// Protected by the semaphore's lock.
+	index   int // in `waiters`, -1 if not queued
+	granted bool
+}
+
+// noSemaphoreLimit is the semaphore limit used for `NoConcurrencyLimit`.
+const noSemaphoreLimit = ^uint(0)
+
+func limitToSemaphore(max uint) uint {
+	if max == NoConcurrencyLimit {
+		return noSemaphoreLimit
+	}
+
+	return max
+}
+
+// semaphoreToLimit is the inverse of `limitToSemaphore`.
+func semaphoreToLimit(limit uint) uint {
+	if limit == noSemaphoreLimit {
+		return NoConcurrencyLimit
+	}
+
+	return limit
+}
+
+func newSemaphore(limit uint) *semaphore {
+	return &semaphore{
+		state: zync.NewMutex(semaphoreState{
+			limit: limit,
+			used:  0,
+
+			waiters: nil,
+			nextSeq: 0,
+		}),
+	}
+}
+
+// enqueue takes a place in the queue for `weight` units of capacity.
+//
+// Tickets with a higher priority are granted first, and tickets with the same priority
+// are granted in the order they were queued.
+// The ticket is granted immediately if there's capacity and no other waiters.
+// A ticket heavier than the semaphore's limit is granted once no other ticket is.
+// `leave` must be called once the ticket is no longer needed.
+func (s *semaphore) enqueue(priority int, weight uint) *semaphoreTicket {
+	ticket := &semaphoreTicket{
+		sem: s,
+
+		priority: priority,
+		weight:   weight,
+		seq:      0, // see below
+
+		ready: make(chan struct{}),
+
+		index:   -1, // see below
+		granted: false,
+	}
+
+	s.state.WithLock(func(state *semaphoreState) {
+		ticket.seq = state.nextSeq
+		state.nextSeq++
+
+		heap.Push(&state.waiters, ticket)
+
+		state.grant()
+	})
+
+	return ticket
+}
+
+// acquire is a shortcut for `enqueue` and `wait`.
+//
+// When it returns nil, `leave` must be called on the returned ticket to release it.
+func (s *semaphore) acquire(ctx context.Context, priority int, weight uint) (*semaphoreTicket, error) {
+	ticket := s.enqueue(priority, weight)
+
+	err := ticket.wait(ctx)
+	if err != nil {
+		ticket.leave()
+
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+// setLimit changes the semaphore's limit.
+//
+// Lowering the limit doesn't affect granted tickets: new ones wait for enough capacity to be released.
+func (s *semaphore) setLimit(limit uint) {
+	s.state.WithLock(func(state *semaphoreState) {
+		state.limit = limit
+
+		state.grant()
+	})
+}
+
+// grant admits waiters, in queue order, while there is capacity.
+func (s *semaphoreState) grant() {
+	for len(s.waiters) != 0 && s.fits(s.waiters[0].weight) {
+		ticket := heap.Pop(&s.waiters).(*semaphoreTicket) //nolint:forcetypeassert
+
+		ticket.granted = true
+		s.used += ticket.weight
+
+		close(ticket.ready)
+	}
+}
+
+// fits reports whether `weight` units can be used now.
+func (s *semaphoreState) fits(weight uint) bool {
+	if s.used == 0 {
+		return true // allow waiters heavier than the limit to run alone
+	}
+
+	return s.used < s.limit && weight <= s.limit-s.used
+}
+
+// wait blocks until the ticket is granted, or `ctx` ends.
+//
+// If the ticket is already granted, it returns nil even if `ctx` is done.
+func (t *semaphoreTicket) wait(ctx context.Context) error {
+	select {
+	case <-t.ready:
+		return nil
+	default:
+	}
+
+	select {
+	case <-t.ready:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// leave releases the ticket if it was granted, or removes it from the queue if not.
+//
+// It must be called exactly once per ticket.
+func (t *semaphoreTicket) leave() {
+	t.sem.state.WithLock(func(state *semaphoreState) {
+		if t.granted {
+			state.used -= t.weight
+		} else {
+			heap.Remove(&state.waiters, t.index)
+		}
+
+		state.grant()
+	})
+}
+
+// ticketQueue implements `heap.Interface` to order waiting tickets.
+type ticketQueue []*semaphoreTicket
+
+func (q ticketQueue) Len() int { return len(q) }
+
+func (q ticketQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+
+	return q[i].seq < q[j].seq
+}
+
+func (q ticketQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *ticketQueue) Push(x any) {
+	ticket := x.(*semaphoreTicket) //nolint:forcetypeassert
+
+	ticket.index = len(*q)
+	*q = append(*q, ticket)
+}
+
+func (q *ticketQueue) Pop() any {
+	old := *q
+	n := len(old)
+
+	ticket := old[n-1]
+	old[n-1] = nil // don't keep a reference
+	ticket.index = -1
+
+	*q = old[:n-1]
+
+	return ticket
+}
@@ -0,0 +1,168 @@
+package jobgroup
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("semaphore", func() {
+	var sut *semaphore
+
+	BeforeEach(func() {
+		sut = newSemaphore(1)
+	})
+
+	It("grants tickets immediately when there is capacity", func() {
+		ticket := sut.enqueue(DefaultPriority, DefaultCost)
+		Expect(ticket.ready).Should(BeClosed())
+		Expect(ticket.wait(doneCtx)).Should(Succeed())
+	})
+
+	It("grants tickets in order", func(testCtx context.Context) {
+		first := sut.enqueue(DefaultPriority, DefaultCost)
+		second := sut.enqueue(DefaultPriority, DefaultCost)
+		third := sut.enqueue(DefaultPriority, DefaultCost)
+
+		Expect(second.ready).ShouldNot(BeClosed())
+		Expect(third.ready).ShouldNot(BeClosed())
+
+		first.leave()
+		Expect(second.wait(testCtx)).Should(Succeed())
+		Expect(third.ready).ShouldNot(BeClosed())
+
+		second.leave()
+		Expect(third.wait(testCtx)).Should(Succeed())
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	It("skips tickets that left the queue", func(testCtx context.Context) {
+		first := sut.enqueue(DefaultPriority, DefaultCost)
+		second := sut.enqueue(DefaultPriority, DefaultCost)
+		third := sut.enqueue(DefaultPriority, DefaultCost)
+
+		second.leave()
+		first.leave()
+
+		Expect(third.wait(testCtx)).Should(Succeed())
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	It("grants tickets by priority, then in order", func(testCtx context.Context) {
+		first := sut.enqueue(DefaultPriority, DefaultCost)
+
+		low1 := sut.enqueue(-1, DefaultCost)
+		low2 := sut.enqueue(-1, DefaultCost)
+		high := sut.enqueue(1, DefaultCost)
+		normal := sut.enqueue(DefaultPriority, DefaultCost)
+
+		first.leave()
+		Expect(high.wait(testCtx)).Should(Succeed())
+
+		high.leave()
+		Expect(normal.wait(testCtx)).Should(Succeed())
+
+		normal.leave()
+		Expect(low1.wait(testCtx)).Should(Succeed())
+		Expect(low2.ready).ShouldNot(BeClosed())
+
+		low1.leave()
+		Expect(low2.wait(testCtx)).Should(Succeed())
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	When("tickets have a weight", func() {
+		BeforeEach(func() {
+			sut = newSemaphore(10)
+		})
+
+		It("grants tickets while they fit", func() {
+			first := sut.enqueue(DefaultPriority, 4)
+			second := sut.enqueue(DefaultPriority, 6)
+			third := sut.enqueue(DefaultPriority, 1)
+
+			Expect(first.ready).Should(BeClosed())
+			Expect(second.ready).Should(BeClosed())
+			Expect(third.ready).ShouldNot(BeClosed())
+
+			first.leave()
+			Expect(third.ready).Should(BeClosed())
+		})
+
+		It("doesn't let light tickets overtake heavy ones", func() {
+			first := sut.enqueue(DefaultPriority, 5)
+			heavy := sut.enqueue(DefaultPriority, 10)
+			light := sut.enqueue(DefaultPriority, 1)
+
+			Expect(heavy.ready).ShouldNot(BeClosed())
+			Expect(light.ready).ShouldNot(BeClosed())
+
+			first.leave()
+			Expect(heavy.ready).Should(BeClosed())
+			Expect(light.ready).ShouldNot(BeClosed())
+
+			heavy.leave()
+			Expect(light.ready).Should(BeClosed())
+		})
+
+		It("grants tickets heavier than the limit once alone", func() {
+			first := sut.enqueue(DefaultPriority, 1)
+			huge := sut.enqueue(DefaultPriority, 100)
+
+			Expect(huge.ready).ShouldNot(BeClosed())
+
+			first.leave()
+			Expect(huge.ready).Should(BeClosed())
+		})
+	})
+
+	Describe("setLimit", func() {
+		It("grants waiting tickets when raised", func() {
+			first := sut.enqueue(DefaultPriority, DefaultCost)
+			second := sut.enqueue(DefaultPriority, DefaultCost)
+
+			Expect(second.ready).ShouldNot(BeClosed())
+
+			sut.setLimit(2)
+			Expect(second.ready).Should(BeClosed())
+
+			first.leave()
+			second.leave()
+		})
+
+		It("doesn't affect granted tickets when lowered", func() {
+			sut.setLimit(2)
+
+			first := sut.enqueue(DefaultPriority, DefaultCost)
+			second := sut.enqueue(DefaultPriority, DefaultCost)
+
+			sut.setLimit(1)
+			Expect(first.ready).Should(BeClosed())
+			Expect(second.ready).Should(BeClosed())
+
+			third := sut.enqueue(DefaultPriority, DefaultCost)
+
+			first.leave()
+			Expect(third.ready).ShouldNot(BeClosed())
+
+			second.leave()
+			Expect(third.ready).Should(BeClosed())
+		})
+	})
+
+	Describe("acquire", func() {
+		It("returns the context's error if it ends first", func() {
+			first := sut.enqueue(DefaultPriority, DefaultCost)
+			defer first.leave()
+
+			ticket, err := sut.acquire(doneCtx, DefaultPriority, DefaultCost)
+			Expect(err).Should(MatchError(context.Canceled))
+			Expect(ticket).Should(BeNil())
+
+			By("leaving the queue", func() {
+				sut.state.WithLock(func(state *semaphoreState) {
+					Expect(state.waiters).Should(BeEmpty())
+				})
+			})
+		})
+	})
+})
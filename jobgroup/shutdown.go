@@ -0,0 +1,124 @@
+package jobgroup
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// DefaultShutdownSignals are the signals used by `WithShutdownSignals` when none are given.
+var DefaultShutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM} //nolint:gochecknoglobals
+
+var _ jobGroup = (*shutdownGroup)(nil)
+
+// ShutdownGroup is a `JobGroup` that is cancelled when the process receives a signal.
+type ShutdownGroup interface {
+	JobGroup
+
+	// WaitShutdown waits for all jobs of the group, allowing them a grace period once the group's
+	// context ends.
+	//
+	// It returns once all jobs are done, or the grace period elapses, or a signal is received
+	// during the grace period. In the last two cases, the returned error is a `StuckJobsError`
+	// listing the jobs that were still running.
+	//
+	// Like `Close`, job panics are propagated to the current goroutine, and the group's context
+	// is cancelled once it returns.
+	// WaitShutdown must be called instead of `Close`, which would block forever on stuck jobs.
+	WaitShutdown() error
+}
+
+type shutdownGroup struct {
+	withContext
+
+	gracePeriod time.Duration
+	signals     chan os.Signal
+}
+
+// WithShutdownSignals creates a root `ShutdownGroup` whose context is cancelled when the process receives
+// one of `signals`, or `DefaultShutdownSignals` if none are given.
+//
+// The group's context is a child of the given context, but the group is never the child of another group.
+//
+// `gracePeriod` is how long `WaitShutdown` waits for jobs once the group's context ends.
+// If it is 0, `WaitShutdown` waits until all jobs are done, or a second signal is received.
+func WithShutdownSignals(ctx context.Context, gracePeriod time.Duration, signals ...os.Signal) ShutdownGroup {
+	if len(signals) == 0 {
+		signals = DefaultShutdownSignals
+	}
+
+	group := &shutdownGroup{
+		withContext: newWithContext(),
+
+		gracePeriod: gracePeriod,
+		signals:     make(chan os.Signal, 1),
+	}
+
+	initGroup(ctx, group)
+
+	signal.Notify(group.signals, signals...)
+
+	go group.cancelOnSignal()
+
+	return group
+}
+
+func (g *shutdownGroup) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *shutdownGroup) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithShutdownSignals"
+}
+
+func (g *shutdownGroup) Close() {
+	defer signal.Stop(g.signals)
+
+	g.withContext.Close()
+}
+
+func (g *shutdownGroup) cancelOnSignal() {
+	select {
+	case <-g.signals:
+		g.Cancel()
+
+	case <-g.ctx.Done():
+	}
+}
+
+func (g *shutdownGroup) WaitShutdown() error {
+	defer signal.Stop(g.signals)
+	defer g.Cancel() // prevent group reuse
+
+	if err, ok := g.WaitCtx(g.ctx); ok {
+		return err
+	}
+
+	// The group's context ended: give jobs the grace period to stop.
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if g.gracePeriod != 0 {
+		ctx, cancel = context.WithTimeout(ctx, g.gracePeriod)
+		defer cancel()
+	}
+
+	go func() {
+		select {
+		case <-g.signals:
+			cancel()
+
+		case <-ctx.Done():
+		}
+	}()
+
+	err, ok := g.WaitCtx(ctx)
+	if !ok {
+		return newStuckJobsError(g.running.info(true))
+	}
+
+	return err
+}
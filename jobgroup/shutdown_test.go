@@ -0,0 +1,133 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"os"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("shutdownGroup", func() {
+	var (
+		sut            *shutdownGroup
+		sutGracePeriod time.Duration
+	)
+
+	BeforeEach(func() {
+		sutGracePeriod = 0
+	})
+
+	JustBeforeEach(func() {
+		// Use a signal the test runner doesn't listen to
+		sut = WithShutdownSignals(context.Background(), sutGracePeriod, syscall.SIGHUP).(*shutdownGroup)
+		Expect(sut).ShouldNot(BeNil())
+
+		DeferCleanup(sut.Cancel)
+	})
+
+	Describe("WithShutdownSignals", func() {
+		It("cancels the group when a signal is received", func(testCtx context.Context) {
+			process, err := os.FindProcess(os.Getpid())
+			Expect(err).Should(Succeed())
+
+			Expect(process.Signal(syscall.SIGHUP)).Should(Succeed())
+
+			Eventually(testCtx, sut.Ctx().Done()).Should(BeClosed())
+
+			// Stop listening to the signal
+			Expect(sut.WaitShutdown()).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	Describe("WaitShutdown", func() {
+		It("returns once all jobs are done", func(testCtx context.Context) {
+			expectedErr := errors.New("expected error")
+
+			sut.Go(func(ctx context.Context) error {
+				return expectedErr
+			})
+
+			Expect(sut.WaitShutdown()).Should(MatchError(expectedErr))
+			Expect(sut.Ctx().Err()).Should(HaveOccurred())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("waits for jobs to stop once cancelled", func(testCtx context.Context) {
+			started := make(chan struct{})
+			stopped := make(chan struct{})
+
+			sut.Go(func(ctx context.Context) error {
+				close(started)
+
+				<-ctx.Done()
+
+				time.Sleep(10 * time.Millisecond)
+				close(stopped)
+
+				return nil
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			sut.signals <- syscall.SIGHUP
+
+			Expect(sut.WaitShutdown()).Should(Succeed())
+			Expect(stopped).Should(BeClosed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		When("there is a grace period", func() {
+			BeforeEach(func() {
+				sutGracePeriod = 10 * time.Millisecond
+			})
+
+			It("reports jobs still running after it", func(testCtx context.Context) {
+				started := make(chan struct{})
+
+				sut.Go(func(ctx context.Context) error {
+					close(started)
+
+					<-testCtx.Done()
+
+					return nil
+				})
+
+				Eventually(testCtx, started).Should(BeClosed())
+
+				sut.Cancel()
+
+				err := sut.WaitShutdown()
+
+				var stuckErr *StuckJobsError
+				Expect(errors.As(err, &stuckErr)).Should(BeTrue())
+				Expect(stuckErr.Jobs()).Should(HaveLen(1))
+				Expect(stuckErr.Jobs()[0].Stack).Should(ContainSubstring("shutdown_test.go"))
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+
+		It("stops waiting on a second signal", func(testCtx context.Context) {
+			started := make(chan struct{})
+
+			sut.Go(func(ctx context.Context) error {
+				close(started)
+
+				<-testCtx.Done()
+
+				return nil
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			sut.signals <- syscall.SIGHUP
+
+			Eventually(testCtx, sut.Ctx().Done()).Should(BeClosed())
+
+			sut.signals <- syscall.SIGHUP
+
+			err := sut.WaitShutdown()
+			Expect(errors.As(err, new(*StuckJobsError))).Should(BeTrue())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+})
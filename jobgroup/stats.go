@@ -0,0 +1,187 @@
+package jobgroup
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// GroupStats are counters describing the jobs of a group, see `JobGroup.Stats`.
+//
+// Like `Wait`, they include the jobs of child groups.
+type GroupStats struct {
+	// Started is the number of jobs that started running.
+	Started uint64
+
+	// Completed is the number of jobs that returned nil.
+	Completed uint64
+
+	// Failed is the number of jobs that returned an error.
+	Failed uint64
+
+	// Panicked is the number of jobs that panicked.
+	Panicked uint64
+
+	// NotStarted is the number of jobs that never ran, because the group's context ended first.
+	NotStarted uint64
+
+	// Running is the number of jobs currently running.
+	Running uint64
+
+	// Waiting is the number of jobs currently waiting to start, for example for a concurrency limit.
+	Waiting uint64
+
+	// WaitTime is the total time jobs waited before starting.
+	WaitTime time.Duration
+
+	// WaitTimes is the distribution of the time jobs waited before starting.
+	WaitTimes DurationHistogram
+
+	// RunTimes is the distribution of the time jobs ran, once done.
+	RunTimes DurationHistogram
+}
+
+// DurationHistogram is a snapshot of the distribution of durations.
+type DurationHistogram struct {
+	// Buckets are the upper bounds of the buckets, in increasing order.
+	Buckets []time.Duration
+
+	// Counts are the number of durations less than or equal to the bound of each bucket.
+	Counts []uint64
+
+	// Count is the total number of durations, including those above the last bucket.
+	Count uint64
+
+	// Sum is the sum of all durations.
+	Sum time.Duration
+}
+
+// histogramBuckets are the buckets of `DurationHistogram`, from 1ms to 10s.
+var histogramBuckets = [...]time.Duration{ //nolint:gochecknoglobals
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// groupStats are the counters of a group, updated by each of its jobs.
+type groupStats struct {
+	started    atomic.Uint64
+	completed  atomic.Uint64
+	failed     atomic.Uint64
+	panicked   atomic.Uint64
+	notStarted atomic.Uint64
+
+	running atomic.Int64
+	waiting atomic.Int64
+
+	waitTimes durationHistogram
+	runTimes  durationHistogram
+}
+
+// durationHistogram counts durations in `histogramBuckets`.
+type durationHistogram struct {
+	buckets [len(histogramBuckets) + 1]atomic.Uint64 // last is for durations above all buckets
+	sum     atomic.Int64                             // nanoseconds
+}
+
+func (g *withContext) Stats() GroupStats {
+	return g.stats.snapshot()
+}
+
+// add counts `job` until it is done.
+func (s *groupStats) add(job *boundJob) {
+	s.waiting.Add(1)
+
+	job.stats = append(job.stats, s)
+
+	job.Defer(func() {
+		s.jobDone(job)
+	})
+}
+
+func (s *groupStats) jobStarted(waited time.Duration) {
+	s.waiting.Add(-1)
+	s.running.Add(1)
+
+	s.started.Add(1)
+	s.waitTimes.observe(waited)
+}
+
+func (s *groupStats) jobDone(job *boundJob) {
+	if job.runningSince.Load() == 0 {
+		s.waiting.Add(-1)
+		s.notStarted.Add(1)
+
+		return
+	}
+
+	s.running.Add(-1)
+
+	s.runTimes.observe(time.Since(time.Unix(0, job.runningSince.Load())))
+
+	switch {
+	case job.panicked:
+		s.panicked.Add(1)
+
+	case job.result != nil:
+		s.failed.Add(1)
+
+	default:
+		s.completed.Add(1)
+	}
+}
+
+func (s *groupStats) snapshot() GroupStats {
+	return GroupStats{
+		Started:    s.started.Load(),
+		Completed:  s.completed.Load(),
+		Failed:     s.failed.Load(),
+		Panicked:   s.panicked.Load(),
+		NotStarted: s.notStarted.Load(),
+
+		Running: uint64(s.running.Load()),
+		Waiting: uint64(s.waiting.Load()),
+
+		WaitTime: time.Duration(s.waitTimes.sum.Load()),
+
+		WaitTimes: s.waitTimes.snapshot(),
+		RunTimes:  s.runTimes.snapshot(),
+	}
+}
+
+func (h *durationHistogram) observe(d time.Duration) {
+	i := sort.Search(len(histogramBuckets), func(i int) bool {
+		return d <= histogramBuckets[i]
+	})
+
+	h.buckets[i].Add(1)
+	h.sum.Add(int64(d))
+}
+
+func (h *durationHistogram) snapshot() DurationHistogram {
+	res := DurationHistogram{
+		Buckets: append([]time.Duration(nil), histogramBuckets[:]...),
+		Counts:  make([]uint64, len(histogramBuckets)),
+		Count:   0, // see below
+		Sum:     time.Duration(h.sum.Load()),
+	}
+
+	for i := range h.buckets {
+		res.Count += h.buckets[i].Load()
+
+		if i < len(res.Counts) {
+			res.Counts[i] = res.Count
+		}
+	}
+
+	return res
+}
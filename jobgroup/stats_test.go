@@ -0,0 +1,173 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stats", func() {
+	Describe("DurationHistogram", func() {
+		It("counts durations in cumulative buckets", func() {
+			var histogram durationHistogram
+
+			histogram.observe(0)
+			histogram.observe(time.Millisecond)
+			histogram.observe(3 * time.Millisecond)
+			histogram.observe(time.Minute)
+
+			snapshot := histogram.snapshot()
+			Expect(snapshot.Buckets).Should(Equal(histogramBuckets[:]))
+			Expect(snapshot.Counts).Should(HaveLen(len(histogramBuckets)))
+			Expect(snapshot.Counts[0]).Should(BeNumerically("==", 2))                       // <= 1ms
+			Expect(snapshot.Counts[1]).Should(BeNumerically("==", 3))                       // <= 5ms
+			Expect(snapshot.Counts[len(histogramBuckets)-1]).Should(BeNumerically("==", 3)) // <= 10s
+			Expect(snapshot.Count).Should(BeNumerically("==", 4))
+			Expect(snapshot.Sum).Should(Equal(time.Minute + 4*time.Millisecond))
+		})
+	})
+
+	var sut JobGroup
+
+	BeforeEach(func() {
+		sut, _ = WithContext(context.Background())
+		DeferCleanup(sut.Close)
+	})
+
+	It("starts at zero", func() {
+		stats := sut.Stats()
+
+		Expect(stats.Started).Should(BeZero())
+		Expect(stats.Completed).Should(BeZero())
+		Expect(stats.Failed).Should(BeZero())
+		Expect(stats.Panicked).Should(BeZero())
+		Expect(stats.NotStarted).Should(BeZero())
+		Expect(stats.Running).Should(BeZero())
+		Expect(stats.Waiting).Should(BeZero())
+		Expect(stats.WaitTime).Should(BeZero())
+
+		Expect(stats.WaitTimes.Count).Should(BeZero())
+		Expect(stats.WaitTimes.Counts).Should(HaveEach(BeZero()))
+		Expect(stats.RunTimes.Count).Should(BeZero())
+	})
+
+	It("counts job outcomes", func(testCtx context.Context) {
+		group := WithPanicPolicy(sut, ConvertPanics)
+
+		group.Go(func(ctx context.Context) error {
+			return nil
+		})
+
+		group.Go(func(ctx context.Context) error {
+			return errors.New("test error")
+		})
+
+		group.Go(func(ctx context.Context) error {
+			panic("test panic")
+		})
+
+		err, ok := group.WaitCtx(testCtx)
+		Expect(ok).Should(BeTrue())
+		Expect(err).Should(HaveOccurred())
+
+		stats := group.Stats()
+		Expect(stats.Started).Should(BeNumerically("==", 3))
+		Expect(stats.Completed).Should(BeNumerically("==", 1))
+		Expect(stats.Failed).Should(BeNumerically("==", 1))
+		Expect(stats.Panicked).Should(BeNumerically("==", 1))
+		Expect(stats.NotStarted).Should(BeZero())
+		Expect(stats.Running).Should(BeZero())
+		Expect(stats.Waiting).Should(BeZero())
+
+		// Jobs of child groups are also counted
+		parentStats := sut.Stats()
+		Expect(parentStats.Started).Should(Equal(stats.Started))
+		Expect(parentStats.Completed).Should(Equal(stats.Completed))
+		Expect(parentStats.Failed).Should(Equal(stats.Failed))
+		Expect(parentStats.Panicked).Should(Equal(stats.Panicked))
+		Expect(parentStats.RunTimes.Count).Should(Equal(stats.RunTimes.Count))
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	It("counts jobs that didn't start", func(testCtx context.Context) {
+		sut.Cancel()
+
+		sut.Go(func(ctx context.Context) error {
+			return nil
+		})
+
+		err, ok := sut.WaitCtx(testCtx)
+		Expect(ok).Should(BeTrue())
+		Expect(err).Should(HaveOccurred())
+
+		stats := sut.Stats()
+		Expect(stats.NotStarted).Should(BeNumerically("==", 1))
+		Expect(stats.Started).Should(BeZero())
+		Expect(stats.Waiting).Should(BeZero())
+		Expect(stats.WaitTimes.Count).Should(BeZero())
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	It("counts running and waiting jobs", func(testCtx context.Context) {
+		child := WithMaxConcurrency(sut, 1)
+		defer child.Close()
+
+		started := make(chan struct{})
+		done := make(chan struct{})
+
+		child.Go(func(ctx context.Context) error {
+			close(started)
+			<-done
+
+			return nil
+		})
+
+		Eventually(testCtx, started).Should(BeClosed())
+
+		child.Go(func(ctx context.Context) error {
+			return nil
+		})
+
+		stats := child.Stats()
+		Expect(stats.Started).Should(BeNumerically("==", 1))
+		Expect(stats.Running).Should(BeNumerically("==", 1))
+		Expect(stats.Waiting).Should(BeNumerically("==", 1))
+
+		time.Sleep(10 * time.Millisecond)
+		close(done)
+
+		err, ok := child.WaitCtx(testCtx)
+		Expect(ok).Should(BeTrue())
+		Expect(err).Should(Succeed())
+
+		stats = child.Stats()
+		Expect(stats.Started).Should(BeNumerically("==", 2))
+		Expect(stats.Completed).Should(BeNumerically("==", 2))
+		Expect(stats.Running).Should(BeZero())
+		Expect(stats.Waiting).Should(BeZero())
+		Expect(stats.WaitTime).Should(BeNumerically(">=", 10*time.Millisecond))
+
+		Expect(stats.WaitTimes.Count).Should(BeNumerically("==", 2))
+		Expect(stats.WaitTimes.Sum).Should(Equal(stats.WaitTime))
+		Expect(stats.RunTimes.Count).Should(BeNumerically("==", 2))
+		Expect(stats.RunTimes.Sum).Should(BeNumerically(">=", 10*time.Millisecond))
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+	It("counts retried jobs once", func(testCtx context.Context) {
+		group := WithRetry(sut, RetryPolicy{MaxAttempts: 3}) //nolint:exhaustruct
+
+		group.Go(func(ctx context.Context) error {
+			return errors.New("test error")
+		})
+
+		err, ok := group.WaitCtx(testCtx)
+		Expect(ok).Should(BeTrue())
+		Expect(err).Should(HaveOccurred())
+
+		stats := group.Stats()
+		Expect(stats.Started).Should(BeNumerically("==", 1))
+		Expect(stats.Failed).Should(BeNumerically("==", 1))
+		Expect(stats.Running).Should(BeZero())
+	}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+})
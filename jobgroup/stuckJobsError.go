@@ -0,0 +1,50 @@
+package jobgroup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StuckJobsError is returned when jobs were still running after a group stopped waiting for them.
+type StuckJobsError struct {
+	jobs []JobInfo
+}
+
+func newStuckJobsError(jobs []JobInfo) *StuckJobsError {
+	return &StuckJobsError{jobs: jobs}
+}
+
+// Error implements `error`.
+func (e *StuckJobsError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d job(s) still running", len(e.jobs))
+
+	for i, job := range e.jobs {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString(", ")
+		}
+
+		if job.Started.IsZero() {
+			fmt.Fprintf(&b, "%s (waiting since %s)", job.Name, job.Submitted.Format(time.RFC3339))
+		} else {
+			fmt.Fprintf(&b, "%s (started %s)", job.Name, job.Started.Format(time.RFC3339))
+		}
+	}
+
+	for _, job := range e.jobs {
+		if job.Stack != "" {
+			fmt.Fprintf(&b, "\n\n%s:\n%s", job.Name, job.Stack)
+		}
+	}
+
+	return b.String()
+}
+
+// Jobs returns the jobs that were still running, oldest first.
+func (e *StuckJobsError) Jobs() []JobInfo {
+	return e.jobs
+}
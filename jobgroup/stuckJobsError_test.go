@@ -0,0 +1,57 @@
+package jobgroup
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StuckJobsError", func() {
+	var jobs []JobInfo
+
+	BeforeEach(func() {
+		jobs = []JobInfo{
+			{Name: "test.job1", Started: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{Name: "test.job2", Started: time.Date(2000, 1, 1, 0, 0, 1, 0, time.UTC)},
+		}
+	})
+
+	Describe("Error", func() {
+		It("lists the jobs", func() {
+			sut := newStuckJobsError(jobs)
+
+			Expect(sut.Error()).Should(ContainSubstring("2 job(s)"))
+
+			for _, job := range jobs {
+				Expect(sut.Error()).Should(ContainSubstring(job.Name))
+				Expect(sut.Error()).Should(ContainSubstring(job.Started.Format(time.RFC3339)))
+			}
+		})
+
+		It("lists when waiting jobs were submitted", func() {
+			jobs[1].Submitted = jobs[1].Started
+			jobs[1].Started = time.Time{}
+
+			sut := newStuckJobsError(jobs)
+
+			Expect(sut.Error()).Should(ContainSubstring("test.job2 (waiting since " + jobs[1].Submitted.Format(time.RFC3339)))
+		})
+
+		It("contains the stacks", func() {
+			jobs[1].Stack = "goroutine 1 [running]:\ntest.job2()"
+
+			sut := newStuckJobsError(jobs)
+
+			Expect(sut.Error()).Should(ContainSubstring(jobs[1].Stack))
+		})
+	})
+
+	Describe("Jobs", func() {
+		It("returns the jobs", func() {
+			sut := newStuckJobsError(jobs)
+
+			Expect(sut.Jobs()).Should(Equal(jobs))
+		})
+	})
+})
@@ -0,0 +1,331 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ThinkChaos/parcour/zync"
+)
+
+// NoRestartLimit is used to signify supervised jobs can be restarted any number of times.
+const NoRestartLimit = 0
+
+// RestartPolicy defines when a supervised job is restarted after it returns.
+type RestartPolicy int
+
+const (
+	// Permanent jobs are always restarted.
+	Permanent RestartPolicy = iota
+
+	// Transient jobs are restarted only if they fail: return an error or panic.
+	Transient
+
+	// Temporary jobs are never restarted, even when restarting other jobs.
+	Temporary
+)
+
+// SupervisorStrategy defines which jobs are restarted when a supervised job is restarted.
+type SupervisorStrategy int
+
+const (
+	// OneForOne only restarts the job that returned.
+	OneForOne SupervisorStrategy = iota
+
+	// OneForAll also restarts all other supervised jobs.
+	OneForAll
+
+	// RestForOne also restarts the supervised jobs registered after the one that returned.
+	RestForOne
+)
+
+// DefaultRestartBackoff is the `SupervisorConfig.Backoff` used when none is given.
+var DefaultRestartBackoff = ExponentialBackoff(10*time.Millisecond, time.Second) //nolint:gochecknoglobals
+
+// errRestart is the cancellation cause of a job restarted because of another job.
+var errRestart = errors.New("restarted by supervisor")
+
+var _ jobGroup = (*supervisor)(nil)
+
+// SupervisorConfig configures a `WithSupervisor` group.
+type SupervisorConfig struct {
+	// Strategy is which jobs are restarted when a job is restarted.
+	Strategy SupervisorStrategy
+
+	// MaxRestarts is the maximum number of restarts allowed in `Window`.
+	// When it is exceeded, the supervisor gives up: see `WithSupervisor`.
+	//
+	// If it is `NoRestartLimit`, jobs are restarted any number of times.
+	MaxRestarts uint
+
+	// Window is the duration over which restarts are counted.
+	//
+	// If it is 0, restarts are counted over the supervisor's whole lifetime.
+	Window time.Duration
+
+	// Backoff returns how long to wait before the given restart of a job.
+	// `restart` is 1 for the first restart of the job.
+	//
+	// Jobs restarted because of `Strategy` don't wait.
+	//
+	// If it is nil, `DefaultRestartBackoff` is used, so jobs that return immediately don't
+	// restart in a busy loop.
+	Backoff func(restart uint) time.Duration
+}
+
+// Supervisor is a `JobGroup` that can restart its jobs.
+type Supervisor interface {
+	JobGroup
+
+	// Supervise starts a job as part of the group, restarting it when it returns according to `policy`.
+	//
+	// Jobs started with other methods, or from child groups, are not supervised.
+	Supervise(policy RestartPolicy, job Job)
+}
+
+type supervisor struct {
+	withParent
+
+	config SupervisorConfig
+	state  zync.Mutex[supervisorState]
+}
+
+type supervisorState struct {
+	jobs     []*supervisedJob // in registration order
+	restarts []time.Time      // in `config.Window`
+}
+
+type supervisedJob struct {
+	policy RestartPolicy
+	run    Job
+
+	// cancel cancels the current run of the job.
+	// Protected by the supervisor's lock.
+	cancel context.CancelCauseFunc
+}
+
+// WithSupervisor returns a new `Supervisor`, child of `parent`, that restarts jobs registered with
+// `Supervise` when they return, in the style of Erlang/OTP supervisors.
+//
+// When a job is restarted, the other jobs restarted because of `config.Strategy` are cancelled, and
+// start again once they return. Those restarts don't count towards `config.MaxRestarts`.
+//
+// A supervised job that panics is restarted as if it failed with a `JobPanicError`.
+// Only the panic of the run that ends supervision, for example if the job is not restarted, is
+// handled like those of other jobs, see `WithPanicPolicy`.
+// If `config.MaxRestarts` is exceeded because of a panic, the `MaxRestartsError` wraps the `JobPanicError`.
+//
+// If `config.MaxRestarts` is exceeded, the supervisor cancels its context, and a `MaxRestartsError`
+// is returned by `Wait`, or propagated to the parent on `Close`.
+//
+// Once the supervisor's context ends, jobs are no longer restarted, and their errors are handled
+// like those of any other job.
+func WithSupervisor(parent JobGroup, config SupervisorConfig) Supervisor {
+	group := &supervisor{
+		withParent: newWithParent(parent),
+
+		config: config, // see below
+		state: zync.NewMutex(supervisorState{
+			jobs:     nil,
+			restarts: nil,
+		}),
+	}
+
+	if group.config.Backoff == nil {
+		group.config.Backoff = DefaultRestartBackoff
+	}
+
+	initGroup(parent.Ctx(), group)
+
+	return group
+}
+
+func (g *supervisor) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *supervisor) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithSupervisor"
+}
+
+func (g *supervisor) Supervise(policy RestartPolicy, userJob Job) {
+	job := &supervisedJob{
+		policy: policy,
+		run:    userJob,
+
+		cancel: nil, // see supervise
+	}
+
+	var index int
+
+	g.state.WithLock(func(state *supervisorState) {
+		index = len(state.jobs)
+		state.jobs = append(state.jobs, job)
+	})
+
+	var bound *boundJob
+
+	bound = bindJob(g, func(ctx context.Context) error {
+		return g.supervise(ctx, index, job, bound)
+	})
+
+	// Report the user's job rather than the supervision loop, for example in `Inspect`.
+	bound.userJob = userJob
+
+	g.launch(bound)
+}
+
+// supervise runs `job` until it shouldn't be restarted.
+func (g *supervisor) supervise(ctx context.Context, index int, job *supervisedJob, bound *boundJob) error {
+	var restarts uint
+
+	for {
+		runCtx, cancel := context.WithCancelCause(ctx)
+
+		g.state.WithLock(func(*supervisorState) {
+			job.cancel = cancel
+		})
+
+		err, panicked := job.runOnce(runCtx, bound)
+
+		restarted := errors.Is(context.Cause(runCtx), errRestart)
+
+		cancel(nil)
+
+		switch {
+		case ctx.Err() != nil:
+			return ended(err, panicked)
+
+		case restarted:
+			if job.policy == Temporary {
+				return nil
+			}
+
+			continue
+
+		case !job.policy.restarts(err):
+			return ended(err, panicked)
+		}
+
+		if escalated := g.restart(index, job, err); escalated != nil {
+			g.Cancel()
+
+			return escalated
+		}
+
+		restarts++
+
+		if !g.backoff(ctx, restarts) {
+			return ended(err, panicked)
+		}
+	}
+}
+
+// ended returns the error of the run that ended supervision.
+//
+// If the run panicked, the panic is raised again so it's handled by the group's `PanicPolicy`.
+func ended(err error, panicked bool) error {
+	if panicked {
+		panic(err)
+	}
+
+	return err
+}
+
+// backoff blocks for the backoff duration before the given restart.
+//
+// It returns false if `ctx` ended first.
+func (g *supervisor) backoff(ctx context.Context, restart uint) bool {
+	delay := g.config.Backoff(restart)
+	if delay <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return ctx.Err() == nil
+
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// restart records a restart of `job`, and restarts other jobs according to the strategy.
+//
+// If the restart exceeds the limit, an error is returned instead.
+func (g *supervisor) restart(index int, job *supervisedJob, cause error) error {
+	var err error
+
+	g.state.WithLock(func(state *supervisorState) {
+		if g.config.MaxRestarts != NoRestartLimit {
+			now := time.Now()
+
+			if g.config.Window != 0 {
+				for len(state.restarts) != 0 && now.Sub(state.restarts[0]) > g.config.Window {
+					state.restarts = state.restarts[1:]
+				}
+			}
+
+			state.restarts = append(state.restarts, now)
+
+			if uint(len(state.restarts)) > g.config.MaxRestarts {
+				err = newMaxRestartsError(g.config.MaxRestarts, g.config.Window, cause)
+
+				return
+			}
+		}
+
+		var others []*supervisedJob
+
+		switch g.config.Strategy {
+		case OneForOne:
+		case OneForAll:
+			others = state.jobs
+		case RestForOne:
+			others = state.jobs[index+1:]
+		}
+
+		for _, other := range others {
+			if other != job && other.cancel != nil {
+				other.cancel(errRestart)
+			}
+		}
+	})
+
+	return err
+}
+
+// restarts reports whether a job that returned `err` should be restarted.
+func (p RestartPolicy) restarts(err error) bool {
+	switch p {
+	case Permanent:
+		return true
+
+	case Transient:
+		return err != nil
+
+	case Temporary:
+		return false
+	}
+
+	panic(fmt.Sprintf("unknown RestartPolicy: %d", p))
+}
+
+// runOnce runs the job, recovering a panic as an error.
+//
+// The panic is not handled by the group's `PanicPolicy`: it is a restart cause, see `ended`.
+// `bound` is the job the receiver is supervised by.
+func (j *supervisedJob) runOnce(ctx context.Context, bound *boundJob) (err error, panicked bool) {
+	defer func() {
+		if val := recover(); val != nil {
+			err = bound.panicError(val)
+			panicked = true
+		}
+	}()
+
+	return j.run(ctx), false
+}
@@ -0,0 +1,443 @@
+package jobgroup
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("supervisor", func() {
+	var (
+		sutParent     *MockjobGroup
+		sutParentCtx  *identifiableContext
+		sutParentImpl jobGroup
+
+		sut       *supervisor
+		sutConfig SupervisorConfig
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+
+		sutParentCtx = newIdentifiableContext(context.Background())
+		DeferCleanup(sutParentCtx.Cancel)
+
+		sutParent = NewMockjobGroup(ctrl)
+
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
+		sutParent.EXPECT().
+			Ctx().
+			Return(sutParentCtx)
+
+		group, _ := WithContext(sutParentCtx)
+		DeferCleanup(group.Close)
+
+		sutParentImpl = downcastGroup(group)
+
+		sutConfig = SupervisorConfig{
+			Strategy:    OneForOne,
+			MaxRestarts: NoRestartLimit,
+			Window:      0,
+			Backoff:     func(uint) time.Duration { return 0 },
+		}
+	})
+
+	JustBeforeEach(func() {
+		sut = WithSupervisor(sutParent, sutConfig).(*supervisor)
+		Expect(sut).ShouldNot(BeNil())
+		Expect(sut.parent).ShouldNot(BeNil())
+		Expect(sut.parent).Should(BeIdenticalTo(sutParent))
+
+		DeferCleanup(sut.Close)
+
+		sutParent.EXPECT().
+			launch(gomock.Any()).
+			AnyTimes().
+			Do(sutParentImpl.launch)
+	})
+
+	// stopAfter returns a job that returns `err` the first `n` runs, and then blocks until its context is done.
+	stopAfter := func(n int32, err error) (Job, *int32) {
+		runs := new(int32)
+
+		return func(ctx context.Context) error {
+			if atomic.AddInt32(runs, 1) <= n {
+				return err
+			}
+
+			return blockUntilCtxDone(ctx)
+		}, runs
+	}
+
+	Describe("Supervise", func() {
+		It("restarts permanent jobs", func(testCtx context.Context) {
+			job, runs := stopAfter(3, nil)
+
+			sut.Supervise(Permanent, job)
+
+			Eventually(testCtx, func() int32 { return atomic.LoadInt32(runs) }).Should(BeNumerically("==", 4))
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("restarts transient jobs only if they fail", func(testCtx context.Context) {
+			runs := new(int32)
+
+			sut.Supervise(Transient, func(ctx context.Context) error {
+				if atomic.AddInt32(runs, 1) <= 2 {
+					return errors.New("test error")
+				}
+
+				return nil
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+			Expect(atomic.LoadInt32(runs)).Should(BeNumerically("==", 3))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("doesn't restart temporary jobs", func(testCtx context.Context) {
+			expectedErr := errors.New("expected error")
+			runs := new(int32)
+
+			sut.Supervise(Temporary, func(ctx context.Context) error {
+				atomic.AddInt32(runs, 1)
+
+				return expectedErr
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(MatchError(expectedErr))
+			Expect(atomic.LoadInt32(runs)).Should(BeNumerically("==", 1))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("restarts jobs that panic, without handling the panics", func(testCtx context.Context) {
+			runs := new(int32)
+
+			sut.Supervise(Transient, func(ctx context.Context) error {
+				if atomic.AddInt32(runs, 1) <= 3 {
+					panic("test panic")
+				}
+
+				return nil
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+			Expect(atomic.LoadInt32(runs)).Should(BeNumerically("==", 4))
+			Expect(sut.Stats().Panicked).Should(BeZero())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("handles the panic that ends supervision like other jobs", func(testCtx context.Context) {
+			sut.Supervise(Temporary, func(ctx context.Context) error {
+				panic("test panic")
+			})
+
+			Expect(func() { sut.WaitCtx(testCtx) }).Should(PanicWith(HaveField("Value()", "test panic")))
+			Eventually(testCtx, func() uint64 { return sut.Stats().Panicked }).Should(BeNumerically("==", 1))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("uses the group's panic policy", func(testCtx context.Context) {
+			root, _ := WithContext(context.Background())
+			DeferCleanup(root.Close)
+
+			sut := WithSupervisor(WithPanicPolicy(root, ConvertPanics), sutConfig)
+
+			runs := new(int32)
+
+			sut.Supervise(Transient, func(ctx context.Context) error {
+				atomic.AddInt32(runs, 1)
+
+				<-ctx.Done()
+
+				panic("test panic")
+			})
+
+			Eventually(testCtx, func() int32 { return atomic.LoadInt32(runs) }).Should(BeNumerically("==", 1))
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(errors.As(err, new(*JobPanicError))).Should(BeTrue())
+			Expect(atomic.LoadInt32(runs)).Should(BeNumerically("==", 1))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		When("there is a backoff", func() {
+			var restarts []uint
+
+			BeforeEach(func() {
+				restarts = nil
+
+				sutConfig.Backoff = func(restart uint) time.Duration {
+					restarts = append(restarts, restart)
+
+					return time.Millisecond
+				}
+			})
+
+			It("waits before each restart", func(testCtx context.Context) {
+				job, runs := stopAfter(3, nil)
+
+				sut.Supervise(Permanent, job)
+
+				Eventually(testCtx, func() int32 { return atomic.LoadInt32(runs) }).Should(BeNumerically("==", 4))
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(Succeed())
+				Expect(restarts).Should(Equal([]uint{1, 2, 3}))
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+			It("stops waiting when the group is cancelled", func(testCtx context.Context) {
+				sut.config.Backoff = func(uint) time.Duration { return time.Hour }
+
+				expectedErr := errors.New("expected error")
+
+				job, runs := stopAfter(1, expectedErr)
+
+				sut.Supervise(Permanent, job)
+
+				Eventually(testCtx, func() int32 { return atomic.LoadInt32(runs) }).Should(BeNumerically("==", 1))
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(MatchError(expectedErr))
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+
+		When("there is no backoff", func() {
+			BeforeEach(func() {
+				sutConfig.Backoff = nil
+			})
+
+			It("uses DefaultRestartBackoff", func(testCtx context.Context) {
+				job, runs := stopAfter(100, nil)
+
+				sut.Supervise(Permanent, job)
+
+				Consistently(testCtx, func() int32 { return atomic.LoadInt32(runs) }).
+					WithTimeout(50 * time.Millisecond).
+					Should(BeNumerically("<", 10))
+
+				sut.Cancel()
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+
+		When("the strategy is OneForAll", func() {
+			BeforeEach(func() {
+				sutConfig.Strategy = OneForAll
+			})
+
+			It("restarts all jobs", func(testCtx context.Context) {
+				job1, job1Runs := stopAfter(0, nil)
+
+				sut.Supervise(Permanent, job1)
+
+				Eventually(testCtx, func() int32 { return atomic.LoadInt32(job1Runs) }).Should(BeNumerically("==", 1))
+
+				job2, _ := stopAfter(1, nil)
+
+				sut.Supervise(Permanent, job2)
+
+				Eventually(testCtx, func() int32 { return atomic.LoadInt32(job1Runs) }).Should(BeNumerically("==", 2))
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(Succeed())
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+			It("doesn't restart other temporary jobs", func(testCtx context.Context) {
+				started := make(chan struct{})
+				temporaryDone := make(chan struct{})
+
+				sut.Supervise(Temporary, func(ctx context.Context) error {
+					defer close(temporaryDone)
+
+					close(started)
+
+					return blockUntilCtxDoneErr(ctx)
+				})
+
+				Eventually(testCtx, started).Should(BeClosed())
+
+				job, _ := stopAfter(1, errors.New("test error"))
+
+				sut.Supervise(Permanent, job)
+
+				Eventually(testCtx, temporaryDone).Should(BeClosed())
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(Succeed())
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+
+		When("the strategy is RestForOne", func() {
+			BeforeEach(func() {
+				sutConfig.Strategy = RestForOne
+			})
+
+			It("restarts jobs registered after the restarted one", func(testCtx context.Context) {
+				events := make(chan string, 10)
+				restart := make(chan struct{})
+
+				sut.Supervise(Permanent, func(ctx context.Context) error {
+					events <- "job 0 start"
+
+					return blockUntilCtxDone(ctx)
+				})
+
+				Eventually(testCtx, events).Should(Receive(Equal("job 0 start")))
+
+				sut.Supervise(Permanent, func(ctx context.Context) error {
+					events <- "job 1 start"
+
+					select {
+					case <-restart:
+						return nil
+					case <-ctx.Done():
+						return nil
+					}
+				})
+
+				Eventually(testCtx, events).Should(Receive(Equal("job 1 start")))
+
+				sut.Supervise(Permanent, func(ctx context.Context) error {
+					events <- "job 2 start"
+
+					return blockUntilCtxDone(ctx)
+				})
+
+				Eventually(testCtx, events).Should(Receive(Equal("job 2 start")))
+
+				restart <- struct{}{}
+
+				Eventually(testCtx, events).Should(Receive(Equal("job 1 start")))
+				Eventually(testCtx, events).Should(Receive(Equal("job 2 start")))
+				Consistently(events, 20*time.Millisecond).ShouldNot(Receive())
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(Succeed())
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+
+		When("there is a restart limit", func() {
+			BeforeEach(func() {
+				sutConfig.MaxRestarts = 2
+				sutConfig.Window = time.Minute
+			})
+
+			It("gives up once it is exceeded", func(testCtx context.Context) {
+				expectedErr := errors.New("expected error")
+
+				job, runs := stopAfter(10, expectedErr)
+
+				sut.Supervise(Permanent, job)
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(MatchError(expectedErr))
+
+				var maxRestartsErr *MaxRestartsError
+				Expect(errors.As(err, &maxRestartsErr)).Should(BeTrue())
+				Expect(maxRestartsErr.MaxRestarts()).Should(Equal(sutConfig.MaxRestarts))
+
+				Expect(atomic.LoadInt32(runs)).Should(BeNumerically("==", 3))
+				Expect(sut.Ctx().Err()).Should(HaveOccurred())
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+			It("wraps the panic that exceeded it", func(testCtx context.Context) {
+				sut.Supervise(Permanent, func(ctx context.Context) error {
+					panic("test panic")
+				})
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(errors.As(err, new(*MaxRestartsError))).Should(BeTrue())
+
+				var panicErr *JobPanicError
+				Expect(errors.As(err, &panicErr)).Should(BeTrue())
+				Expect(panicErr.Value()).Should(Equal("test panic"))
+				Expect(sut.Stats().Panicked).Should(BeZero())
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+			It("escalates to the parent on Close", func(testCtx context.Context) {
+				sutParent.EXPECT().
+					saveErr(gomock.Any()).
+					Do(func(err error) {
+						Expect(errors.As(err, new(*MaxRestartsError))).Should(BeTrue())
+					})
+
+				job, _ := stopAfter(10, errors.New("test error"))
+
+				sut.Supervise(Permanent, job)
+
+				sut.Close()
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+			It("only counts restarts in the window", func(testCtx context.Context) {
+				sut.config.Window = time.Nanosecond
+
+				job, runs := stopAfter(10, errors.New("test error"))
+
+				sut.Supervise(Permanent, job)
+
+				Eventually(testCtx, func() int32 { return atomic.LoadInt32(runs) }).Should(BeNumerically("==", 11))
+
+				sut.Cancel()
+
+				err, ok := sut.WaitCtx(testCtx)
+				Expect(ok).Should(BeTrue())
+				Expect(err).Should(Succeed())
+			}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+		})
+	})
+
+	Describe("Go", func() {
+		It("doesn't restart jobs", func(testCtx context.Context) {
+			runs := new(int32)
+
+			sut.Go(func(ctx context.Context) error {
+				atomic.AddInt32(runs, 1)
+
+				return nil
+			})
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+			Expect(atomic.LoadInt32(runs)).Should(BeNumerically("==", 1))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+})
@@ -0,0 +1,67 @@
+package jobgroup
+
+// DefaultCost is the cost of jobs started without an explicit cost.
+const DefaultCost = 1
+
+var _ jobGroup = (*weightedMaxConcurrency)(nil)
+
+// WeightedJobGroup is a `JobGroup` whose jobs can use more than one unit of its capacity.
+type WeightedJobGroup interface {
+	JobGroup
+
+	// GoWithCost is like `Go`, but the job uses `cost` units of the group's capacity while it runs.
+	//
+	// A job costing 0 doesn't use any capacity: it starts immediately, even if the group is at capacity
+	// or other jobs are waiting.
+	//
+	// Jobs started with other methods, or from child groups, have `DefaultCost`.
+	GoWithCost(cost uint, job Job)
+}
+
+type weightedMaxConcurrency struct {
+	fairMaxConcurrency
+}
+
+// WithWeightedMaxConcurrency returns a new `JobGroup`, child of `parent`, that limits the total cost
+// of concurrent jobs to `capacity`.
+//
+// The cost is any unit meaningful to the caller, for example MB of memory or DB connections.
+//
+// Jobs start in the order they were submitted: a job that doesn't fit blocks the jobs submitted
+// after it, even if they would fit. This ensures costly jobs don't wait forever.
+// A job whose cost exceeds `capacity` starts once no other job of the group is running.
+//
+// If `capacity` is `NoConcurrencyLimit`, jobs never wait so costs have no effect.
+func WithWeightedMaxConcurrency(parent JobGroup, capacity uint) WeightedJobGroup {
+	group := &weightedMaxConcurrency{
+		fairMaxConcurrency: fairMaxConcurrency{
+			withParent: newWithParent(parent),
+
+			sem: newSemaphore(limitToSemaphore(capacity)),
+		},
+	}
+
+	initGroup(parent.Ctx(), group)
+
+	return group
+}
+
+func (g *weightedMaxConcurrency) Go(job Job) {
+	g.launch(bindJob(g, job))
+}
+
+func (g *weightedMaxConcurrency) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithWeightedMaxConcurrency"
+	snapshot.Concurrency = g.sem.usage()
+}
+
+func (g *weightedMaxConcurrency) GoWithCost(cost uint, job Job) {
+	if cost == 0 {
+		// Nothing to wait for
+		g.withParent.launch(bindJob(g, job))
+
+		return
+	}
+
+	g.launchWith(bindJob(g, job), DefaultPriority, cost)
+}
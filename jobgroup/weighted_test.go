@@ -0,0 +1,196 @@
+package jobgroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThinkChaos/parcour/zync"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("weightedMaxConcurrency", func() {
+	var (
+		sutParent     *MockjobGroup
+		sutParentCtx  *identifiableContext
+		sutParentImpl jobGroup
+
+		sut         *weightedMaxConcurrency
+		sutCapacity uint
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+
+		sutParentCtx = newIdentifiableContext(context.Background())
+		DeferCleanup(sutParentCtx.Cancel)
+
+		sutParent = NewMockjobGroup(ctrl)
+
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
+		sutParent.EXPECT().
+			Ctx().
+			Return(sutParentCtx)
+
+		group, _ := WithContext(sutParentCtx)
+		DeferCleanup(group.Close)
+
+		sutParentImpl = downcastGroup(group)
+
+		sutCapacity = 10
+	})
+
+	JustBeforeEach(func() {
+		sut = WithWeightedMaxConcurrency(sutParent, sutCapacity).(*weightedMaxConcurrency)
+		Expect(sut).ShouldNot(BeNil())
+		Expect(sut.parent).ShouldNot(BeNil())
+		Expect(sut.parent).Should(BeIdenticalTo(sutParent))
+
+		DeferCleanup(sut.Close)
+
+		sutParent.EXPECT().
+			launch(gomock.Any()).
+			AnyTimes().
+			Do(sutParentImpl.launch)
+	})
+
+	Describe("GoWithCost", func() {
+		It("runs jobs concurrently while their total cost fits", func(testCtx context.Context) {
+			events := make(chan string)
+
+			sut.GoWithCost(4, func(ctx context.Context) error {
+				events <- "job 1 start"
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			sut.GoWithCost(6, func(ctx context.Context) error {
+				events <- "job 2 start"
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, events).Should(Receive())
+			Eventually(testCtx, events).Should(Receive())
+
+			sut.Go(func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Fail("job 3 should never run")
+
+				return nil
+			})
+
+			Consistently(events, 20*time.Millisecond).ShouldNot(Receive())
+
+			sut.Cancel()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).ShouldNot(Succeed()) // job 3 was not started
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("doesn't starve costly jobs", func(testCtx context.Context) {
+			blockCtx, unblock := context.WithCancel(testCtx)
+			started := make(chan struct{})
+
+			sut.GoWithCost(5, func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(blockCtx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			order := zync.NewMutex([]string(nil))
+			job := func(name string) Job {
+				return func(context.Context) error {
+					order.WithLock(func(order *[]string) {
+						*order = append(*order, name)
+					})
+
+					return nil
+				}
+			}
+
+			sut.GoWithCost(sutCapacity, job("costly"))
+			sut.Go(job("cheap"))
+
+			// "cheap" would fit, but must wait for "costly"
+			Consistently(func() []string {
+				val, unlock := order.Lock()
+				defer unlock()
+
+				return *val
+			}, 20*time.Millisecond).Should(BeEmpty())
+
+			unblock()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+
+			order.WithLock(func(order *[]string) {
+				Expect(*order).Should(Equal([]string{"costly", "cheap"}))
+			})
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("runs jobs costing more than the capacity alone", func(testCtx context.Context) {
+			ran := make(chan struct{})
+
+			sut.GoWithCost(sutCapacity*2, func(ctx context.Context) error {
+				close(ran)
+
+				return nil
+			})
+
+			Eventually(testCtx, ran).Should(BeClosed())
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("runs jobs costing nothing immediately", func(testCtx context.Context) {
+			blockCtx, unblock := context.WithCancel(testCtx)
+			started := make(chan struct{})
+
+			sut.GoWithCost(sutCapacity, func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(blockCtx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			// Waits for the first job
+			sut.GoWithCost(1, func(ctx context.Context) error {
+				return nil
+			})
+
+			ran := make(chan struct{})
+
+			sut.GoWithCost(0, func(ctx context.Context) error {
+				close(ran)
+
+				return nil
+			})
+
+			Eventually(testCtx, ran).Should(BeClosed())
+
+			unblock()
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+})
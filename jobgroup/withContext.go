@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ThinkChaos/parcour/zync"
 )
@@ -14,6 +15,14 @@ type ctxKeyType struct{}
 var (
 	ctxKey = new(ctxKeyType) //nolint:gochecknoglobals
 
+	// doneCtx is an already done context, used to not block in `admit`.
+	doneCtx = func() context.Context { //nolint:gochecknoglobals
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		return ctx
+	}()
+
 	_ jobGroup = (*withContext)(nil)
 )
 
@@ -43,18 +52,31 @@ func WithContext(ctx context.Context) (JobGroup, context.Context) {
 type withContext struct {
 	failures
 
-	wg sync.WaitGroup
+	running  runningJobs
+	children childGroups
+	stats    groupStats
+
+	wg     sync.WaitGroup
 	ctx    context.Context //nolint:containedctx
 	cancel context.CancelFunc
+
+	// self is the group that embeds the receiver, see `init`.
+	self jobGroup
 }
 
 func newWithContext() withContext {
 	return withContext{
 		failures: failures{},
 
-		wg: sync.WaitGroup{},
+		running:  newRunningJobs(),
+		children: newChildGroups(),
+		stats:    groupStats{},
+
+		wg:     sync.WaitGroup{},
 		ctx:    nil, // see init
 		cancel: nil, // see init
+
+		self: nil, // see init
 	}
 }
 
@@ -71,6 +93,11 @@ func (g *withContext) init(ctx context.Context, selfWrapped JobGroup) {
 	// Store `selfWrapped` so when recovered from the context, the `Go` method is the correct one,
 	// and we don't loose the specialties of the group.
 	g.ctx = context.WithValue(ctx, ctxKey, selfWrapped)
+
+	// Also store it directly so methods that are not redefined by each group type can use it.
+	g.self = downcastGroup(selfWrapped)
+
+	g.failures.policy = errorPolicyFromCtx(ctx)
 }
 
 func (g *withContext) Ctx() context.Context {
@@ -94,15 +121,81 @@ func (g *withContext) close() error {
 	return g.Wait()
 }
 
+func (g *withContext) CloseCtx(ctx context.Context) error {
+	g.Cancel()
+
+	if !g.waitJobs(ctx) {
+		return newStuckJobsError(g.running.info(true))
+	}
+
+	g.self.Close() // doesn't block anymore
+
+	return nil
+}
+
+func (g *withContext) CloseTimeout(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return g.CloseCtx(ctx)
+}
+
 func (g *withContext) Go(job Job) {
 	g.launch(bindJob(g, job))
 }
 
+func (g *withContext) TryGo(job Job) bool {
+	return g.GoCtx(doneCtx, job) == nil
+}
+
+func (g *withContext) GoCtx(ctx context.Context, userJob Job) error {
+	job := bindJob(g.self, userJob)
+
+	err := g.self.admit(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	job.admitted = true
+
+	g.self.launch(job)
+
+	return nil
+}
+
+func (g *withContext) addChild(child jobGroup) {
+	g.children.add(child)
+}
+
+func (g *withContext) removeChild(child jobGroup) {
+	g.children.remove(child)
+}
+
+func (g *withContext) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithContext"
+}
+
+func (g *withContext) admit(context.Context, *boundJob) error {
+	return nil // no limits
+}
+
 func (g *withContext) launch(job *boundJob) {
+	g.track(job)
+
+	if job.observation != nil {
+		job.observation.jobQueued(job)
+	}
+
+	go job.Main()
+}
+
+// track makes the group wait for `job`.
+func (g *withContext) track(job *boundJob) {
 	g.wg.Add(1)
 	job.Defer(g.wg.Done)
 
-	go job.Main()
+	g.running.add(job)
+	g.stats.add(job)
 }
 
 func (g *withContext) Wait() error {
@@ -112,6 +205,20 @@ func (g *withContext) Wait() error {
 }
 
 func (g *withContext) WaitCtx(ctx context.Context) (error, bool) {
+	if !g.waitJobs(ctx) {
+		return nil, false
+	}
+
+	// Propagate panics and errors, at most once
+	err := g.failures.propagate()
+
+	return err, true
+}
+
+// waitJobs blocks until all jobs are done, or `ctx` ends.
+//
+// It returns true when all jobs are done.
+func (g *withContext) waitJobs(ctx context.Context) bool {
 	wait := make(chan struct{})
 
 	go func() {
@@ -121,29 +228,45 @@ func (g *withContext) WaitCtx(ctx context.Context) (error, bool) {
 
 	select {
 	case <-wait:
+		return true
+
 	case <-ctx.Done():
-		return nil, false
+		return false
 	}
-
-	// Propagate panics and errors, at most once
-	err := g.failures.take().propagate()
-
-	return err, true
 }
 
 type failures struct {
 	zync.Mutex[failuresData]
+
+	policy *ErrorPolicy // nil keeps all errors
 }
 
 func (r *failures) saveErr(err error) {
 	r.WithLock(func(res *failuresData) {
-		res.err = append(res.err, err)
+		if r.policy == nil {
+			res.err = append(res.err, err)
+
+			return
+		}
+
+		if agg, ok := err.(*AggregateError); ok { //nolint:errorlint // only unpack errors from child groups
+			// Keep the total count accurate
+			res.errCount += agg.count - uint(len(agg.errs))
+
+			for _, err := range agg.errs {
+				r.policy.save(res, err)
+			}
+
+			return
+		}
+
+		r.policy.save(res, err)
 	})
 }
 
-func (r *failures) savePanic(value any) {
+func (r *failures) savePanic(err *JobPanicError) {
 	r.WithLock(func(res *failuresData) {
-		res.panic = append(res.panic, value)
+		res.panic = append(res.panic, err)
 	})
 }
 
@@ -151,21 +274,32 @@ func (r *failures) take() failuresData {
 	return takeMutexValue(&r.Mutex)
 }
 
-type failuresData struct {
-	err   []error
-	panic []any
-}
+// propagate panics if there were any panics, or returns the errors.
+func (r *failures) propagate() error {
+	data := r.take()
 
-func (d failuresData) propagate() error {
-	if d.panic != nil {
-		if len(d.panic) == 1 {
-			panic(d.panic[0])
+	if data.panic != nil {
+		if len(data.panic) == 1 {
+			panic(data.panic[0])
 		}
 
-		panic(d.panic)
+		panic(newJobPanicsError(data.panic))
 	}
 
-	return errors.Join(d.err...)
+	if r.policy == nil || data.errCount == 0 {
+		return errors.Join(data.err...)
+	}
+
+	return newAggregateError(data.err, data.errCount)
+}
+
+type failuresData struct {
+	err   []error
+	panic []*JobPanicError
+
+	// Only used with an `ErrorPolicy`.
+	errCount     uint   // including errors that were not kept
+	dedupMatched []bool // for each `ErrorPolicy.Deduplicate` target
 }
 
 func takeMutexValue[T any](mutex *zync.Mutex[T]) T {
@@ -179,6 +179,89 @@ var _ = Describe("withContext", func() {
 		})
 	})
 
+	Describe("CloseCtx", func() {
+		It("cancels the group and closes it once jobs are done", func(testCtx context.Context) {
+			started := make(chan struct{})
+
+			sut.Go(func(ctx context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(ctx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			Expect(sut.CloseCtx(testCtx)).Should(Succeed())
+			Expect(sut.Ctx().Err()).ShouldNot(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("panics if a job returns an error", func(testCtx context.Context) {
+			expectedErr := errors.New("expected error")
+
+			sut.Go(func(context.Context) error {
+				return expectedErr
+			})
+
+			// Wait without taking the error
+			Eventually(testCtx, sut.running.info).WithArguments(false).Should(BeEmpty())
+
+			Expect(func() { _ = sut.CloseCtx(testCtx) }).Should(PanicWith(MatchError(expectedErr)))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("reports stuck jobs", func(testCtx context.Context) {
+			started := make(chan struct{})
+			jobCtx, jobEnd := context.WithCancel(testCtx)
+
+			sut.Go(func(context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(jobCtx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			ctx, cancel := context.WithTimeout(testCtx, 10*time.Millisecond)
+			defer cancel()
+
+			err := sut.CloseCtx(ctx)
+
+			var stuckErr *StuckJobsError
+			Expect(errors.As(err, &stuckErr)).Should(BeTrue())
+			Expect(stuckErr.Jobs()).Should(HaveLen(1))
+
+			job := stuckErr.Jobs()[0]
+			Expect(job.Name).Should(HavePrefix("github.com/ThinkChaos/parcour/jobgroup."))
+			Expect(job.Started).Should(BeTemporally(">=", job.Submitted))
+			Expect(job.Stack).Should(ContainSubstring("blockUntilCtxDone"))
+
+			jobEnd()
+
+			Expect(sut.CloseCtx(testCtx)).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	Describe("CloseTimeout", func() {
+		It("reports stuck jobs", func(testCtx context.Context) {
+			started := make(chan struct{})
+			jobCtx, jobEnd := context.WithCancel(testCtx)
+
+			sut.Go(func(context.Context) error {
+				close(started)
+
+				return blockUntilCtxDone(jobCtx)
+			})
+
+			Eventually(testCtx, started).Should(BeClosed())
+
+			err := sut.CloseTimeout(10 * time.Millisecond)
+			Expect(errors.As(err, new(*StuckJobsError))).Should(BeTrue())
+
+			jobEnd()
+
+			Expect(sut.CloseTimeout(time.Second)).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
 	Describe("Go", func() {
 		It("should not block the current goroutine", func(testCtx context.Context) {
 			sut, _ := WithContext(testCtx)
@@ -217,6 +300,54 @@ var _ = Describe("withContext", func() {
 		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
 	})
 
+	Describe("TryGo", func() {
+		It("starts the job", func(testCtx context.Context) {
+			ran := make(chan struct{})
+
+			ok := sut.TryGo(func(ctx context.Context) error {
+				defer GinkgoRecover()
+
+				Expect(ctx).Should(BeIdenticalTo(sut.Ctx()))
+
+				close(ran)
+
+				return nil
+			})
+			Expect(ok).Should(BeTrue())
+
+			Eventually(testCtx, ran).Should(BeClosed())
+			Expect(sut.Wait()).Should(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
+	Describe("GoCtx", func() {
+		It("starts the job", func(testCtx context.Context) {
+			expectedErr := errors.New("expected error")
+
+			err := sut.GoCtx(testCtx, func(ctx context.Context) error {
+				return expectedErr
+			})
+			Expect(err).Should(Succeed())
+
+			err, ok := sut.WaitCtx(testCtx)
+			Expect(ok).Should(BeTrue())
+			Expect(err).Should(MatchError(expectedErr))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("uses the group type's Go", func(testCtx context.Context) {
+			group := WithCancelOnError(sut)
+			defer group.Close()
+
+			err := group.GoCtx(testCtx, func(ctx context.Context) error {
+				return errors.New("expected error")
+			})
+			Expect(err).Should(Succeed())
+
+			Eventually(testCtx, group.Ctx().Done()).Should(BeClosed())
+			Expect(group.Wait()).ShouldNot(Succeed())
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
 	Describe("Wait", func() {
 		It("doesn't block when no job was started", func() {
 			Expect(sut.Wait()).Should(Succeed())
@@ -310,7 +441,7 @@ var _ = Describe("withContext", func() {
 				panic(expectedVal)
 			})
 
-			Expect(func() { group.WaitCtx(testCtx) }).To(PanicWith(expectedVal))
+			Expect(func() { group.WaitCtx(testCtx) }).To(PanicWith(HaveField("Value()", expectedVal)))
 		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
 
 		It("only propagates an error once", func(testCtx context.Context) {
@@ -342,7 +473,7 @@ var _ = Describe("withContext", func() {
 				panic(expectedVal)
 			})
 
-			Expect(func() { group.WaitCtx(testCtx) }).To(PanicWith(expectedVal))
+			Expect(func() { group.WaitCtx(testCtx) }).To(PanicWith(HaveField("Value()", expectedVal)))
 
 			err, ok := group.WaitCtx(testCtx)
 			Expect(ok).Should(BeTrue())
@@ -390,7 +521,21 @@ var _ = Describe("withContext", func() {
 				return nil
 			})
 
-			Expect(func() { group.WaitCtx(testCtx) }).To(PanicWith(expectedVal))
+			Expect(func() { group.WaitCtx(testCtx) }).To(PanicWith(HaveField("Value()", expectedVal)))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+
+		It("propagates the panic's stack and job", func(testCtx context.Context) {
+			group, _ := WithContext(testCtx)
+			defer group.Close()
+
+			group.GoNamed("test job", nil, func(ctx context.Context) error {
+				panic("panic value")
+			})
+
+			Expect(func() { group.WaitCtx(testCtx) }).To(PanicWith(SatisfyAll(
+				HaveField("Name()", "test job"),
+				HaveField("Stack()", WithTransform(func(b []byte) string { return string(b) }, ContainSubstring("withContext_test.go"))),
+			)))
 		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
 
 		It("propagates all errors when multiple jobs fail", func(testCtx context.Context) {
@@ -447,7 +592,18 @@ var _ = Describe("withContext", func() {
 			})
 
 			Expect(func() { group.WaitCtx(testCtx) }).To(
-				PanicWith(SatisfyAll(HaveLen(2), ContainElements(expectedVal1, expectedVal2))),
+				PanicWith(WithTransform(
+					func(err *JobPanicsError) []any {
+						values := make([]any, 0, len(err.Panics()))
+
+						for _, p := range err.Panics() {
+							values = append(values, p.Value())
+						}
+
+						return values
+					},
+					SatisfyAll(HaveLen(2), ContainElements(expectedVal1, expectedVal2)),
+				)),
 			)
 		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
 	})
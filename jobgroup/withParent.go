@@ -34,7 +34,16 @@ func newWithParent(parent JobGroup) withParent {
 	}
 }
 
+func (g *withParent) init(ctx context.Context, selfWrapped JobGroup) {
+	g.withContext.init(ctx, selfWrapped)
+
+	// Register the wrapped group so `Inspect` has the specialties of the group.
+	g.parent.addChild(g.self)
+}
+
 func (g *withParent) Close() {
+	defer g.parent.removeChild(g.self)
+
 	err := g.close() // propagates panics
 	if err != nil {
 		// Propagate unhandled errors to parent
@@ -46,9 +55,16 @@ func (g *withParent) Go(job Job) {
 	g.launch(bindJob(g, job))
 }
 
+func (g *withParent) describe(snapshot *GroupSnapshot) {
+	snapshot.Kind = "WithParent"
+}
+
+func (g *withParent) admit(ctx context.Context, job *boundJob) error {
+	return g.parent.admit(ctx, job)
+}
+
 func (g *withParent) launch(job *boundJob) {
-	g.wg.Add(1)
-	job.Defer(g.wg.Done)
+	g.track(job)
 
 	g.parent.launch(job)
 }
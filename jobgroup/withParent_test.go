@@ -3,6 +3,7 @@ package jobgroup
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -25,6 +26,14 @@ var _ = Describe("withParent", func() {
 
 		sutParent = NewMockjobGroup(ctrl)
 
+		// Child groups register with their parent, see `Inspect`
+		sutParent.EXPECT().
+			addChild(gomock.Any()).
+			AnyTimes()
+		sutParent.EXPECT().
+			removeChild(gomock.Any()).
+			AnyTimes()
+
 		sutParent.EXPECT().
 			Ctx().
 			Return(sutParentCtx)
@@ -102,10 +111,63 @@ var _ = Describe("withParent", func() {
 				panic(expectedVal)
 			})
 
-			Expect(sut.Close).To(PanicWith(expectedVal))
+			Expect(sut.Close).To(PanicWith(HaveField("Value()", expectedVal)))
+		})
+
+		It("should keep the original panic when propagated through a parent job", func() {
+			const expectedVal = "panic value"
+
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			sut.Go(func(ctx context.Context) error {
+				panic(expectedVal)
+			})
+
+			var original *JobPanicError
+
+			func() {
+				defer func() {
+					original = recover().(*JobPanicError) //nolint:forcetypeassert
+
+					// As if the panic happened in a job of the parent group
+					Expect(bindJob(sutParentImpl, nil).recovered(original)).Should(BeIdenticalTo(original))
+				}()
+
+				sut.Close()
+			}()
+
+			Expect(func() { sutParentImpl.Wait() }).To(PanicWith(BeIdenticalTo(original)))
 		})
 	})
 
+	Describe("CloseCtx", func() {
+		It("should propagate errors", func(testCtx context.Context) {
+			expectedErr := errors.New("expected error")
+
+			sutParent.EXPECT().
+				launch(gomock.Any()).
+				Do(sutParentImpl.launch)
+
+			sut.Go(func(ctx context.Context) error {
+				return expectedErr
+			})
+
+			Eventually(testCtx, sut.running.info).WithArguments(false).Should(BeEmpty())
+
+			var err error
+
+			sutParent.EXPECT().
+				saveErr(gomock.Any()).
+				Do(func(toSave error) { err = toSave })
+
+			Expect(sut.CloseCtx(testCtx)).Should(Succeed())
+
+			Expect(err).Should(MatchError(expectedErr))
+		}, SpecTimeout(100*time.Millisecond*timeoutFactor))
+	})
+
 	Describe("Go", func() {
 		It("should call parent.launch", func() {
 			sutParent.EXPECT().
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ThinkChaos/parcour/jobgroup"
 )
@@ -19,6 +20,9 @@ type Producer[T any] func(context.Context, chan<- T) error
 // Consumer is a function that consumes a series of `T`.
 type Consumer[T any] func(context.Context, <-chan T) error
 
+// ItemConsumer is a function that consumes a single `T`, see `Producers.GoConsumeEach`.
+type ItemConsumer[T any] func(context.Context, T) error
+
 // Producers implements a multiple producer, multiple consumer pattern.
 //
 // Producers create a series of items that the consumers... consume!
@@ -28,6 +32,8 @@ type Producers[T any] struct {
 
 	items chan T
 	close sync.Once
+
+	stats producersStats
 }
 
 // NewUnbufferedProducers returns a new `Producers`.
@@ -54,6 +60,8 @@ func NewProducersWithBuffer[T any](producersGrp, consumersGrp jobgroup.JobGroup,
 
 		items: make(chan T, bufferCap), // panics if bufferCap is negative
 		close: sync.Once{},
+
+		stats: newProducersStats(),
 	}
 }
 
@@ -83,6 +91,21 @@ func (p *Producers[T]) BufferCap() int {
 	return cap(p.items)
 }
 
+// ProducersGroup returns the group of the producer jobs.
+//
+// It is a child of the producers group given to the constructor, so it only has the jobs of the receiver.
+// This allows telling producers and consumers apart, for example when using `jobgroup.Metrics`.
+func (p *Producers[T]) ProducersGroup() jobgroup.JobGroup {
+	return p.producersGrp
+}
+
+// ConsumersGroup returns the group of the consumer jobs.
+//
+// Like `ProducersGroup`, it only has the jobs of the receiver.
+func (p *Producers[T]) ConsumersGroup() jobgroup.JobGroup {
+	return p.consumersGrp
+}
+
 // GoProduce starts a new producer job.
 func (p *Producers[T]) GoProduce(producer Producer[T]) {
 	p.producersGrp.Go(func(ctx context.Context) error {
@@ -97,6 +120,25 @@ func (p *Producers[T]) GoConsume(consumer Consumer[T]) {
 	})
 }
 
+// GoConsumeEach starts a new consumer job that calls `consumer` for each item it receives.
+//
+// The job returns once all items are received, or if `consumer` returns an error.
+// Unlike items received by consumers started with `GoConsume`, the items are counted in `Stats`.
+func (p *Producers[T]) GoConsumeEach(consumer ItemConsumer[T]) {
+	p.consumersGrp.Go(func(ctx context.Context) error {
+		for item := range p.items {
+			p.stats.received(time.Now().Unix())
+
+			err := consumer(ctx, item)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 // Wait blocks the current goroutine until all producer and consumer jobs to finish.
 func (p *Producers[T]) Wait() error {
 	err := wrapProducersError(p.producersGrp.Wait())
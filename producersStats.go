@@ -0,0 +1,131 @@
+package parcour
+
+import (
+	"expvar"
+	"sync/atomic"
+	"time"
+
+	"github.com/ThinkChaos/parcour/jobgroup"
+)
+
+// rateWindow is the number of seconds `ProducersStats.ItemsPerSecond` is averaged over.
+const rateWindow = 10
+
+// ProducersStats describes the activity of a `Producers`, see `Producers.Stats`.
+type ProducersStats struct {
+	// BufferLen is the number of items produced that no consumer received yet.
+	BufferLen int `json:"buffer_len"`
+
+	// BufferCap is the buffer capacity, see `Producers.BufferCap`.
+	BufferCap int `json:"buffer_cap"`
+
+	// Items is the number of items received by consumers started with `Producers.GoConsumeEach`.
+	Items uint64 `json:"items"`
+
+	// ItemsPerSecond is the rate at which `Items` grew, averaged over the last 10 seconds.
+	ItemsPerSecond float64 `json:"items_per_second"`
+
+	// Producers are the stats of the producer jobs.
+	Producers jobgroup.GroupStats `json:"producers"`
+
+	// Consumers are the stats of the consumer jobs.
+	Consumers jobgroup.GroupStats `json:"consumers"`
+}
+
+// producersStats counts the items received by consumers.
+type producersStats struct {
+	items atomic.Uint64
+	rate  itemsRate
+}
+
+// itemsRate counts items per second over the last `rateWindow` seconds, without locking.
+//
+// Each bucket packs a Unix second in its high 32 bits, and the number of items received during
+// that second in its low 32 bits. The extra bucket is the one of the current second, which is
+// not over yet so it isn't part of the rate.
+type itemsRate struct {
+	buckets [rateWindow + 1]atomic.Uint64
+}
+
+func newProducersStats() producersStats {
+	return producersStats{
+		items: atomic.Uint64{},
+		rate: itemsRate{
+			buckets: [rateWindow + 1]atomic.Uint64{},
+		},
+	}
+}
+
+// received counts an item received at the Unix second `now`.
+func (s *producersStats) received(now int64) {
+	s.items.Add(1)
+	s.rate.add(now)
+}
+
+// BufferLen returns the number of items produced that no consumer received yet.
+func (p *Producers[T]) BufferLen() int {
+	return len(p.items)
+}
+
+// Stats returns the current stats of the receiver.
+//
+// Only items received by consumers started with `GoConsumeEach` are counted:
+// those started with `GoConsume` receive from the channel directly.
+//
+// It has no side effects, so it can be called by any number of readers.
+func (p *Producers[T]) Stats() ProducersStats {
+	return ProducersStats{
+		BufferLen: p.BufferLen(),
+		BufferCap: p.BufferCap(),
+
+		Items:          p.stats.items.Load(),
+		ItemsPerSecond: float64(p.stats.rate.total(time.Now().Unix())) / rateWindow,
+
+		Producers: p.producersGrp.Stats(),
+		Consumers: p.consumersGrp.Stats(),
+	}
+}
+
+// PublishExpvar publishes the receiver's `Stats` using `expvar`, so they are served by `/debug/vars`.
+//
+// Like `expvar.Publish`, it panics if `name` is already used.
+func (p *Producers[T]) PublishExpvar(name string) {
+	expvar.Publish(name, expvar.Func(func() any {
+		return p.Stats()
+	}))
+}
+
+// add counts an item received at the Unix second `now`.
+func (r *itemsRate) add(now int64) {
+	second := uint64(uint32(now))
+	bucket := &r.buckets[now%int64(len(r.buckets))]
+
+	for {
+		old := bucket.Load()
+
+		next := second<<32 | 1 // the bucket is of an older second: start over
+		if old>>32 == second {
+			next = old + 1
+		}
+
+		if bucket.CompareAndSwap(old, next) {
+			return
+		}
+	}
+}
+
+// total returns the number of items received during the `rateWindow` seconds before `now`.
+func (r *itemsRate) total(now int64) uint64 {
+	var total uint64
+
+	for i := range r.buckets {
+		val := r.buckets[i].Load()
+
+		age := uint32(now) - uint32(val>>32)
+		if age >= 1 && age <= rateWindow {
+			total += val & (1<<32 - 1)
+		}
+	}
+
+	return total
+}
@@ -0,0 +1,211 @@
+package parcour
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+
+	"github.com/ThinkChaos/parcour/jobgroup"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProducersStats", func() {
+	const nItems = 1000
+
+	var (
+		cap int
+		grp jobgroup.JobGroup
+		sut *Producers[string]
+	)
+
+	BeforeEach(func() {
+		cap = 3
+	})
+
+	JustBeforeEach(func() {
+		grp, _ = jobgroup.WithContext(context.Background())
+		DeferCleanup(grp.Close)
+
+		sut = NewProducersWithBuffer[string](grp, grp, cap)
+		DeferCleanup(sut.Close)
+	})
+
+	Describe("BufferLen", func() {
+		It("returns the number of items not received yet", func() {
+			Expect(sut.BufferLen()).Should(BeZero())
+
+			sut.GoProduce(func(ctx context.Context, ch chan<- string) error {
+				for i := 0; i < cap+1; i++ {
+					ch <- "product" // the last blocks until a consumer starts
+				}
+
+				return nil
+			})
+
+			Eventually(sut.BufferLen).Should(Equal(cap))
+			Consistently(sut.BufferLen).Should(Equal(cap))
+
+			sut.GoConsume(func(ctx context.Context, ch <-chan string) error {
+				for range ch { //nolint:revive // drain
+				}
+
+				return nil
+			})
+
+			Expect(sut.Wait()).Should(Succeed())
+			Expect(sut.BufferLen()).Should(BeZero())
+		})
+	})
+
+	Describe("Stats", func() {
+		It("counts items received by GoConsumeEach consumers", func() {
+			for i := 0; i < nItems; i++ {
+				sut.GoProduce(func(ctx context.Context, ch chan<- string) error {
+					ch <- "product"
+
+					return nil
+				})
+			}
+
+			sut.GoConsumeEach(func(ctx context.Context, item string) error {
+				Expect(item).Should(Equal("product"))
+
+				return nil
+			})
+
+			Expect(sut.Wait()).Should(Succeed())
+
+			stats := sut.Stats()
+			Expect(stats.BufferLen).Should(BeZero())
+			Expect(stats.BufferCap).Should(Equal(cap))
+			Expect(stats.Items).Should(BeNumerically("==", nItems))
+			Expect(stats.Producers.Completed).Should(BeNumerically("==", nItems))
+			Expect(stats.Consumers.Completed).Should(BeNumerically("==", 1))
+		})
+
+		It("doesn't count items received by GoConsume consumers", func() {
+			sut.GoProduce(func(ctx context.Context, ch chan<- string) error {
+				ch <- "product"
+
+				return nil
+			})
+
+			sut.GoConsume(func(ctx context.Context, ch <-chan string) error {
+				for range ch { //nolint:revive // drain
+				}
+
+				return nil
+			})
+
+			Expect(sut.Wait()).Should(Succeed())
+			Expect(sut.Stats().Items).Should(BeZero())
+		})
+
+		It("stops counting when the consumer fails", func() {
+			errTest := errors.New("test")
+
+			sut.GoProduce(func(ctx context.Context, ch chan<- string) error {
+				for i := 0; i < cap; i++ {
+					ch <- "product"
+				}
+
+				return nil
+			})
+
+			sut.GoConsumeEach(func(ctx context.Context, item string) error {
+				return errTest
+			})
+
+			Expect(sut.Wait()).Should(MatchError(errTest))
+			Expect(sut.Stats().Items).Should(BeNumerically("==", 1))
+		})
+
+		It("has no side effects", func() {
+			Expect(sut.Stats()).Should(Equal(sut.Stats()))
+		})
+
+		When("unbuffered", func() {
+			BeforeEach(func() {
+				cap = Unbuffered
+			})
+
+			It("counts items", func() {
+				sut.GoProduce(func(ctx context.Context, ch chan<- string) error {
+					ch <- "product"
+
+					return nil
+				})
+
+				sut.GoConsumeEach(func(ctx context.Context, item string) error {
+					Expect(item).Should(Equal("product"))
+
+					return nil
+				})
+
+				Expect(sut.Wait()).Should(Succeed())
+
+				stats := sut.Stats()
+				Expect(stats.BufferCap).Should(Equal(Unbuffered))
+				Expect(stats.Items).Should(BeNumerically("==", 1))
+				Expect(stats.Producers.Completed).Should(BeNumerically("==", 1))
+			})
+		})
+	})
+
+	Describe("itemsRate", func() {
+		const now = 1_000_000
+
+		var rate itemsRate
+
+		BeforeEach(func() {
+			rate = itemsRate{} //nolint:exhaustruct
+		})
+
+		It("counts items of the last seconds", func() {
+			for i := 0; i < 10; i++ {
+				rate.add(now - 2)
+			}
+
+			for i := 0; i < 20; i++ {
+				rate.add(now - rateWindow)
+			}
+
+			Expect(rate.total(now)).Should(BeNumerically("==", 30))
+		})
+
+		It("ignores items of the current second", func() {
+			rate.add(now)
+
+			Expect(rate.total(now)).Should(BeZero())
+			Expect(rate.total(now + 1)).Should(BeNumerically("==", 1))
+		})
+
+		It("forgets items older than the window", func() {
+			rate.add(now - rateWindow - 1)
+
+			Expect(rate.total(now)).Should(BeZero())
+		})
+
+		It("reuses buckets of older seconds", func() {
+			rate.add(now - int64(len(rate.buckets)))
+			rate.add(now)
+
+			Expect(rate.total(now + 1)).Should(BeNumerically("==", 1))
+		})
+	})
+
+	Describe("PublishExpvar", func() {
+		It("publishes the stats", func() {
+			sut.PublishExpvar("parcour_test_producers")
+
+			var stats map[string]any
+			Expect(json.Unmarshal([]byte(expvar.Get("parcour_test_producers").String()), &stats)).Should(Succeed())
+
+			Expect(stats).Should(HaveKeyWithValue("buffer_cap", BeNumerically("==", cap)))
+			Expect(stats).Should(HaveKeyWithValue("items_per_second", BeNumerically("==", 0)))
+			Expect(stats).Should(HaveKeyWithValue("consumers", HaveKey("started")))
+		})
+	})
+})
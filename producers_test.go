@@ -66,6 +66,11 @@ var _ = Describe("Producers", func() {
 			Expect(sut.consumersGrp.Ctx().Err()).Should(MatchError(grp.Ctx().Err()))
 		})
 
+		It("exposes the child JobGroups", func() {
+			Expect(sut.ProducersGroup()).Should(BeIdenticalTo(sut.producersGrp))
+			Expect(sut.ConsumersGroup()).Should(BeIdenticalTo(sut.consumersGrp))
+		})
+
 		It("panics when cap is negative", func() {
 			Expect(func() {
 				NewProducersWithBuffer[struct{}](grp, grp, -1)